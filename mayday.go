@@ -1,17 +1,26 @@
 package main
 
 import (
-	"archive/tar"
+	"io"
 	"log"
 	"os"
 	"time"
 
 	"github.com/coreos/mayday/mayday"
+	"github.com/coreos/mayday/mayday/containers"
 	"github.com/coreos/mayday/mayday/plugins/command"
+	"github.com/coreos/mayday/mayday/plugins/coredump"
+	"github.com/coreos/mayday/mayday/plugins/cri"
 	"github.com/coreos/mayday/mayday/plugins/docker"
 	"github.com/coreos/mayday/mayday/plugins/file"
 	"github.com/coreos/mayday/mayday/plugins/journal"
+	"github.com/coreos/mayday/mayday/plugins/kubernetes"
+	"github.com/coreos/mayday/mayday/plugins/network"
+	"github.com/coreos/mayday/mayday/plugins/podman"
+	"github.com/coreos/mayday/mayday/plugins/proc"
 	"github.com/coreos/mayday/mayday/plugins/rkt"
+	"github.com/coreos/mayday/mayday/plugins/systemd"
+	"github.com/coreos/mayday/mayday/scrub"
 	mtar "github.com/coreos/mayday/mayday/tar"
 	"github.com/coreos/mayday/mayday/tarable"
 
@@ -27,8 +36,18 @@ const (
 )
 
 type Config struct {
-	Files    []File    `mapstructure:"files"`
-	Commands []Command `mapstructure:"commands"`
+	Files      []File            `mapstructure:"files"`
+	Commands   []Command         `mapstructure:"commands"`
+	Limits     command.Limits    `mapstructure:"limits"` // applied to every command
+	Sampler    Sampler           `mapstructure:"sampler"`
+	Journal    journal.Config    `mapstructure:"journal"`
+	Coredump   coredump.Config   `mapstructure:"coredump"`
+	Rkt        rkt.Config        `mapstructure:"rkt"`
+	Docker     docker.Config     `mapstructure:"docker"`
+	Cri        cri.Config        `mapstructure:"cri"`
+	Podman     podman.Config     `mapstructure:"podman"`
+	Kubernetes kubernetes.Config `mapstructure:"kubernetes"`
+	Env        scrub.Config      `mapstructure:"env"`
 }
 
 type File struct {
@@ -37,29 +56,18 @@ type File struct {
 }
 
 type Command struct {
-	Args []string `mapstructure:"args"`
-	Link string   `mapstructure:"link"`
+	Args     []string       `mapstructure:"args"`
+	Link     string         `mapstructure:"link"`
+	Limits   command.Limits `mapstructure:"limits"`   // overrides the global limits
+	Samples  int            `mapstructure:"samples"`  // run this many times instead of once
+	Interval time.Duration  `mapstructure:"interval"` // time between samples
 }
 
-func openFile(f File) (*file.MaydayFile, error) {
-	content, err := os.Open(f.Name)
-	if err != nil {
-		return nil, err
-	}
-
-	fi, err := os.Stat(f.Name)
-	if err != nil {
-		return nil, err
-	}
-
-	header, err := tar.FileInfoHeader(fi, f.Name)
-	header.Name = f.Name
-	if err != nil {
-		return nil, err
-	}
-
-	opened := file.New(content, header, f.Name, f.Link)
-	return opened, nil
+// Sampler configures periodic sampling of /proc
+type Sampler struct {
+	Interval time.Duration `mapstructure:"interval"`
+	Duration time.Duration `mapstructure:"duration"` // sampling is disabled when zero
+	Formats  []string      `mapstructure:"formats"`  // "json" and/or "csv"
 }
 
 func main() {
@@ -67,12 +75,24 @@ func main() {
 	pflag.BoolP("danger", "d", false, "collect potentially sensitive information (ex, container logs)")
 	pflag.StringP("profile", "p", "", "set of data to be collected (default: everything)")
 	pflag.StringP("output", "o", "", "output file (default: /tmp/mayday-{hostname}-{current time}.tar.gz)")
+	pflag.Duration("deadline", 0, "time limit for collection; sampled commands stop early to honor it (default: none)")
+	pflag.String("journal-since", "", "collect journal entries newer than this, e.g. \"-2d\" or \"2017-01-02 15:04:05\" (default: -7d)")
+	pflag.String("journal-until", "", "collect journal entries older than this")
+	pflag.String("journal-priority", "", "minimum priority of journal entries, e.g. \"warning\" or \"4\"")
+	pflag.String("journal-boot", "", "boots to collect journal entries from: current, previous or all (default: all)")
+	pflag.Int("journal-lines", 0, "collect only the last N journal entries of each unit")
+	pflag.String("journal-output", "", "journalctl output mode, e.g. short-iso, json or export")
+	pflag.String("journal-directory", "", "read journal files from this directory instead of the local journal")
 
 	// binds cli flag "danger" to viper config danger, etc.
 	viper.BindPFlag("danger", pflag.Lookup("danger"))
 	viper.BindPFlag("config", pflag.Lookup("config"))
 	viper.BindPFlag("output", pflag.Lookup("output"))
 	viper.BindPFlag("profile", pflag.Lookup("profile"))
+	viper.BindPFlag("deadline", pflag.Lookup("deadline"))
+	for _, o := range []string{"since", "until", "priority", "boot", "lines", "output", "directory"} {
+		viper.BindPFlag("journal."+o, pflag.Lookup("journal-"+o))
+	}
 	// cli arg takes precendence over anything in config files
 	pflag.Parse()
 
@@ -124,57 +144,219 @@ func main() {
 	// fill C with configuration data
 	viper.Unmarshal(&C)
 
-	journals, err := journal.List()
+	var deadline time.Time
+	if d := viper.GetDuration("deadline"); d > 0 {
+		deadline = time.Now().Add(d)
+	}
+
+	// sampled commands run in the background for the rest of collection
+	var samplers []*command.Sampler
+	var structured []*command.Structured
+	for _, c := range C.Commands {
+		if c.Samples > 1 {
+			s := command.NewSampler(c.Args, c.Link, c.Samples, c.Interval)
+			s.Limits = C.Limits.Merge(c.Limits)
+			s.Start(deadline)
+			samplers = append(samplers, s)
+			if st := s.Structured(); st != nil {
+				structured = append(structured, st)
+			}
+		}
+	}
+
+	var procSamples []*tarable.Output
+	if C.Sampler.Duration > 0 {
+		formats := C.Sampler.Formats
+		if len(formats) == 0 {
+			formats = []string{"json", "csv"}
+		}
+		pc := proc.New(C.Sampler.Interval, C.Sampler.Duration)
+		pc.Start(deadline)
+		procSamples = pc.Outputs(formats)
+	}
+
+	// rkt events are listened to for the rest of collection
+	var rktEvents []*tarable.Output
+	if C.Rkt.Events.Enabled {
+		ec := rkt.NewEventCollector(C.Rkt)
+		ec.Start(deadline)
+		rktEvents = ec.Outputs()
+	}
+
+	journals, err := journal.List(C.Journal)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	env, err := scrub.New(C.Env)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	pods, rktLogs, err := rkt.GetPods()
+	units, err := systemd.List(env)
+	if err != nil {
+		log.Printf("Could not read the state of systemd units: %s", err)
+	}
+	manager, err := systemd.Manager(env)
+	if err != nil {
+		log.Printf("Could not read the state of the systemd manager: %s", err)
+	}
+
+	coredumps := coredump.List(C.Coredump)
+
+	pods, rktOutputs, err := rkt.GetPods(C.Rkt, env)
 	if err != nil {
 		log.Println("Could not connect to rkt. Verify mayday has permissions to launch the rkt client.")
 		log.Printf("Connection error: %s", err)
 	}
 
-	containers, dockerLogs, err := docker.GetContainers()
+	dockerDaemon, err := docker.GetDaemon(C.Docker)
 	if err != nil {
-		log.Println("Could not connect to docker. Verify mayday has permissions to read /var/lib/docker.")
+		log.Printf("Could not reach the docker API: %s", err)
+	}
+	dockerDaemon = append(dockerDaemon, docker.GetHost(C.Docker, env)...)
+
+	dockerContainers, dockerLogs, err := docker.GetContainers(C.Docker, env)
+	if err != nil {
+		log.Println("Could not connect to docker. Verify mayday has permissions to use the docker socket or read /var/lib/docker.")
 		log.Printf("Connection error: %s", err)
 	}
 
+	criContainers, criOutputs, err := cri.GetContainers(C.Cri, env)
+	if err != nil {
+		log.Printf("Could not reach a CRI runtime: %s", err)
+	}
+
+	podmanContainers, podmanOutputs, err := podman.GetContainers(C.Podman, env)
+	if err != nil {
+		log.Printf("Could not reach podman: %s", err)
+	}
+
+	kubernetesNode, err := kubernetes.GetNode(C.Kubernetes, env)
+	if err != nil {
+		log.Printf("Not collecting kubernetes: %s", err)
+	}
+
+	// files reached through several symlinks are only collected once
+	collected := make(map[string]bool)
+	var resolutions []*file.Resolution
 	for _, f := range C.Files {
-		mf, err := openFile(f)
+		fts, r, err := file.Collect(f.Name, f.Link)
+		if r != nil {
+			resolutions = append(resolutions, r)
+		}
 		if err != nil {
 			log.Printf("error opening %s: %s\n", f.Name, err)
-		} else {
-			defer mf.Close()
-			tarables = append(tarables, mf)
+		}
+		for _, ft := range fts {
+			if c, ok := ft.(io.Closer); ok {
+				defer c.Close()
+			}
+			if collected[ft.Name()] {
+				continue
+			}
+			collected[ft.Name()] = true
+			tarables = append(tarables, ft)
 		}
 	}
+	tarables = append(tarables, file.NewReport(resolutions))
 
 	for _, c := range C.Commands {
-		tarables = append(tarables, command.New(c.Args, c.Link))
+		if c.Samples > 1 {
+			continue
+		}
+		cmd := command.New(c.Args, c.Link)
+		cmd.Limits = C.Limits.Merge(c.Limits)
+		tarables = append(tarables, cmd)
+		if st := cmd.Structured(); st != nil {
+			tarables = append(tarables, st)
+		}
+	}
+
+	for _, o := range network.New().Outputs() {
+		tarables = append(tarables, o)
 	}
 
 	for _, j := range journals {
 		tarables = append(tarables, j)
 	}
 
+	for _, u := range units {
+		tarables = append(tarables, u)
+	}
+
+	for _, r := range systemd.Reports(manager, units, env) {
+		tarables = append(tarables, r)
+	}
+
+	tarables = append(tarables, coredump.Outputs(coredumps, C.Coredump)...)
+
+	for _, o := range rktOutputs {
+		tarables = append(tarables, o)
+	}
+
 	for _, p := range pods {
 		tarables = append(tarables, p)
 	}
 
-	for _, l := range rktLogs {
-		tarables = append(tarables, l)
+	for _, o := range dockerDaemon {
+		tarables = append(tarables, o)
 	}
 
-	for _, c := range containers {
+	for _, c := range dockerContainers {
 		tarables = append(tarables, c)
 	}
 
+	for _, o := range docker.Files(dockerContainers) {
+		tarables = append(tarables, o)
+	}
+
 	for _, l := range dockerLogs {
 		tarables = append(tarables, l)
 	}
 
+	for _, o := range criOutputs {
+		tarables = append(tarables, o)
+	}
+
+	for _, c := range criContainers {
+		tarables = append(tarables, c)
+	}
+
+	for _, o := range podmanOutputs {
+		tarables = append(tarables, o)
+	}
+
+	for _, c := range podmanContainers {
+		tarables = append(tarables, c)
+	}
+
+	for _, o := range kubernetesNode {
+		tarables = append(tarables, o)
+	}
+
+	// the containers of every runtime, described the same way
+	described := append(rkt.Containers(pods), docker.Containers(dockerContainers)...)
+	described = append(described, cri.Containers(criContainers)...)
+	described = append(described, podman.Containers(podmanContainers)...)
+	for _, o := range containers.Outputs(described) {
+		tarables = append(tarables, o)
+	}
+
+	// samplers go last, giving them as long as possible to finish
+	for _, s := range samplers {
+		tarables = append(tarables, s)
+	}
+	for _, st := range structured {
+		tarables = append(tarables, st)
+	}
+	for _, o := range procSamples {
+		tarables = append(tarables, o)
+	}
+	for _, o := range rktEvents {
+		tarables = append(tarables, o)
+	}
+
 	now := time.Now().Format("200601021504.999999999")
 
 	outputFile := viper.GetString("output")
@@ -0,0 +1,101 @@
+// Package containers describes the containers of every runtime the same way,
+// in containers/index.json and a file per container, next to the runtimes'
+// own dumps.
+package containers
+
+import (
+	"encoding/json"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+const outputDir = "/containers/"
+
+// The states of a container, whatever its runtime calls them
+const (
+	Created    = "created"
+	Running    = "running"
+	Paused     = "paused"
+	Restarting = "restarting"
+	Exited     = "exited"
+	Dead       = "dead"
+	Unknown    = "unknown"
+)
+
+// Container is what is known of a container of any runtime. Id is unique for
+// its runtime: rkt apps, which have none, are identified as <pod>/<app>.
+type Container struct {
+	Runtime      string     `json:"runtime"`
+	Id           string     `json:"id"`
+	Pod          string     `json:"pod,omitempty"` // for runtimes running containers in pods
+	Name         string     `json:"name"`
+	Image        string     `json:"image"`
+	State        string     `json:"state"`
+	ExitCode     int        `json:"exit_code"`
+	Created      *time.Time `json:"created,omitempty"`
+	Started      *time.Time `json:"started,omitempty"`
+	Exited       *time.Time `json:"exited,omitempty"`
+	RestartCount int        `json:"restart_count"`
+	Networks     []Network  `json:"networks"`
+	Mounts       []Mount    `json:"mounts"`
+	Logs         string     `json:"logs,omitempty"` // in the archive, when collected
+	Dump         string     `json:"dump"`           // the runtime's own dump, in the archive
+}
+
+type Network struct {
+	Name string `json:"name"`
+	IPv4 string `json:"ipv4,omitempty"`
+	IPv6 string `json:"ipv6,omitempty"`
+}
+
+type Mount struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	ReadOnly    bool   `json:"read_only"`
+}
+
+// Time returns t, or nil for the zero time
+func Time(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
+
+// Outputs returns containers/index.json, listing every container, and
+// containers/<runtime>/<id>.json for each one
+func Outputs(cs []*Container) []*tarable.Output {
+	sort.SliceStable(cs, func(i, j int) bool {
+		if cs[i].Runtime != cs[j].Runtime {
+			return cs[i].Runtime < cs[j].Runtime
+		}
+		return cs[i].Id < cs[j].Id
+	})
+	outputs := []*tarable.Output{
+		tarable.NewOutput(outputDir, "index.json", "containers", func() []byte { return marshal(cs) }),
+	}
+	for _, c := range cs {
+		c := c
+		if c.Networks == nil {
+			c.Networks = []Network{}
+		}
+		if c.Mounts == nil {
+			c.Mounts = []Mount{}
+		}
+		outputs = append(outputs, tarable.NewOutput(outputDir, c.Runtime+"/"+c.Id+".json", "", func() []byte { return marshal(c) }))
+	}
+	return outputs
+}
+
+func marshal(v interface{}) []byte {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		log.Printf("error marshalling containers: %s", err)
+		return []byte("json marshal error")
+	}
+	return b
+}
@@ -0,0 +1,49 @@
+package containers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTime(t *testing.T) {
+	assert.Nil(t, Time(time.Time{}))
+	local := time.Date(2017, 3, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600))
+	assert.Equal(t, Time(local).Format(time.RFC3339), "2017-03-01T00:00:00Z")
+}
+
+func TestOutputs(t *testing.T) {
+	cs := []*Container{
+		{Runtime: "rkt", Id: "abc123/etcd", Pod: "abc123", Name: "etcd", State: Running},
+		{Runtime: "docker", Id: "xyz", Name: "db", State: Exited, ExitCode: 137, Mounts: []Mount{{Source: "pgdata", Destination: "/data"}}},
+		{Runtime: "docker", Id: "4fa6", Name: "web", State: Running},
+	}
+	outputs := Outputs(cs)
+
+	var ns []string
+	for _, o := range outputs {
+		ns = append(ns, o.Name())
+	}
+	assert.Equal(t, ns, []string{
+		"/containers/index.json",
+		"/containers/docker/4fa6.json",
+		"/containers/docker/xyz.json",
+		"/containers/rkt/abc123/etcd.json",
+	})
+	assert.Equal(t, outputs[0].Link(), "containers")
+
+	var index []Container
+	assert.Nil(t, json.Unmarshal(outputs[0].Content().Bytes(), &index))
+	assert.Len(t, index, 3)
+	assert.Equal(t, index[1].ExitCode, 137)
+
+	// every field is there, even if empty
+	web := outputs[1].Content().String()
+	assert.Contains(t, web, `"networks": []`)
+	assert.Contains(t, web, `"mounts": []`)
+	assert.Contains(t, web, `"restart_count": 0`)
+	assert.NotContains(t, web, `"pod"`)
+	assert.NotContains(t, web, `"started"`)
+}
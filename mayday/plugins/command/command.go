@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/coreos/mayday/mayday/plugins/command/parse"
 	"github.com/coreos/mayday/mayday/tarable"
 )
 
@@ -23,12 +24,17 @@ type Command struct {
 	link    string        // short name to link to the output (optional), e.g. "free"
 	content *bytes.Buffer // the contents of the command, populated by Run()
 	Output  string        // name of command output file
+	Limits  Limits        // resource limits applied while running
+	parser  parse.Parser  // parses the output, if the command is well known
+	parsed  interface{}   // the parsed output, populated by Run()
+	perr    error         // the error from parsing, populated by Run()
 }
 
 func New(args []string, link string) *Command {
 	c := &Command{}
 	c.args = args
 	c.link = link
+	c.parser = parse.Lookup(args)
 	c.Output = "/mayday_commands/" + strings.Join(c.args, "_")
 	return c
 }
@@ -73,6 +79,11 @@ func (c *Command) Run() error {
 		return fmt.Errorf("could not find %q in PATH", name)
 	}
 
+	attr, err := sysProcAttr(c.Limits)
+	if err != nil {
+		return err
+	}
+
 	// Set up the actual Cmd to be run
 	cmd := exec.Cmd{
 		Path:   p,
@@ -80,21 +91,29 @@ func (c *Command) Run() error {
 		Stdout: writer,
 		// TODO(jonboulle): something with stderr?
 		// sosreport just appears to ignore it entirely.
+		SysProcAttr: attr,
 	}
 
 	// Launch the Cmd, and set up a timeout
 	log.Printf("Running command: %q\n", strings.Join(cmd.Args, " "))
-	cmd.Start()
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	if err := applyLimits(cmd.Process.Pid, c.Limits); err != nil {
+		log.Printf("Error limiting Command %q: %v", strings.Join(cmd.Args, " "), err)
+	}
+
+	timeout := c.Limits.timeout()
 	wc := make(chan error, 1)
 	go func() {
 		wc <- cmd.Wait()
 	}()
 	select {
-	case <-time.After(defaultTimeout):
-		if err := cmd.Process.Kill(); err != nil {
+	case <-time.After(timeout):
+		if err := killGroup(&cmd); err != nil {
 			log.Printf("Error killing Command: %v", err)
 		}
-		return fmt.Errorf("Timed out after %v running Command: %q", defaultTimeout, strings.Join(cmd.Args, " "))
+		return fmt.Errorf("Timed out after %v running Command: %q", timeout, strings.Join(cmd.Args, " "))
 	case err := <-wc:
 		if err != nil {
 			return err
@@ -102,5 +121,24 @@ func (c *Command) Run() error {
 	}
 	// If we get this far, the command succeeded. Huzzah!
 
+	if c.parser != nil {
+		c.parsed, c.perr = c.parser(c.content.Bytes())
+	}
+
 	return nil
 }
+
+// Structured returns the parsed output of the command, to be stored next to
+// the raw output, or nil if the command is not well known
+func (c *Command) Structured() *Structured {
+	if c.parser == nil {
+		return nil
+	}
+	return newStructured(c.Name(), c.link, func() (interface{}, error) {
+		c.Content()
+		if c.parsed == nil && c.perr == nil {
+			return nil, fmt.Errorf("command did not complete")
+		}
+		return c.parsed, c.perr
+	})
+}
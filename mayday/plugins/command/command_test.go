@@ -2,7 +2,10 @@ package command
 
 import (
 	"bytes"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -44,3 +47,86 @@ func TestCommandHeader(t *testing.T) {
 	hdr := cmd.Header()
 	assert.Equal(t, hdr.Name, "/mayday_commands/echo_-e_hello_world_testing")
 }
+
+func nice(n int) *int {
+	return &n
+}
+
+func TestLimitsMerge(t *testing.T) {
+	global := Limits{Nice: nice(10), IOClass: "best-effort", IOLevel: 7, Timeout: time.Minute}
+	merged := global.Merge(Limits{IOClass: "idle", MemoryMB: 256})
+
+	assert.Equal(t, merged, Limits{Nice: nice(10), IOClass: "idle", MemoryMB: 256, Timeout: time.Minute})
+	assert.Equal(t, Limits{}.timeout(), defaultTimeout)
+
+	// a command can set the nice value back to 0
+	assert.Equal(t, *global.Merge(Limits{Nice: nice(0)}).Nice, 0)
+}
+
+func TestCommandTimeout(t *testing.T) {
+	// the backgrounded sleep is in the same process group, and must not keep
+	// the command alive once it times out
+	cmd := New([]string{"sh", "-c", "sleep 5 & sleep 5"}, "")
+	cmd.Limits = Limits{Timeout: 100 * time.Millisecond}
+
+	start := time.Now()
+	err := cmd.Run()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Timed out after 100ms")
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
+
+func TestCommandNice(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("resource limits are only supported on linux")
+	}
+	// field 19 of /proc/<pid>/stat is the nice value; the sleep gives mayday
+	// time to apply limits after the process starts
+	cmd := New([]string{"sh", "-c", `sleep 0.2; cut -d" " -f19 /proc/$$/stat; ulimit -v`}, "")
+	cmd.Limits = Limits{Nice: nice(5), MemoryMB: 512}
+
+	err := cmd.Run()
+	assert.Nil(t, err)
+	assert.Equal(t, cmd.Content().String(), "5\n524288\n")
+}
+
+func TestSampler(t *testing.T) {
+	s := NewSampler([]string{"echo", "hello"}, "hi", 3, 10*time.Millisecond)
+	assert.Equal(t, s.Name(), "/mayday_commands/echo_hello")
+	assert.Equal(t, s.Link(), "hi")
+
+	s.Start(time.Time{})
+	content := s.Content().String()
+
+	assert.Equal(t, strings.Count(content, "hello\n"), 3)
+	assert.Equal(t, strings.Count(content, "=== "), 3)
+}
+
+func TestSamplerDeadline(t *testing.T) {
+	// no run starts after the deadline
+	s := NewSampler([]string{"echo", "hello"}, "", 10, 10*time.Millisecond)
+	s.Start(time.Now().Add(-time.Second))
+	content := s.Content().String()
+
+	assert.Equal(t, strings.Count(content, "hello\n"), 0)
+	assert.Contains(t, content, "stopped after 0 of 10 samples: deadline reached")
+
+	// and a run going on at the deadline is killed then, well before its
+	// own timeout
+	s = NewSampler([]string{"sleep", "5"}, "", 3, 10*time.Millisecond)
+	s.Start(time.Now().Add(100 * time.Millisecond))
+	content = s.Content().String()
+
+	assert.Equal(t, strings.Count(content, "=== error: Timed out"), 1)
+	assert.Contains(t, content, "stopped after 1 of 3 samples: deadline reached")
+}
+
+func TestCommandStructured(t *testing.T) {
+	assert.Nil(t, New([]string{"echo", "hello"}, "").Structured())
+
+	cmd := New([]string{"df"}, "df")
+	st := cmd.Structured()
+	assert.Equal(t, st.Name(), "/mayday_commands/df.json")
+	assert.Equal(t, st.Link(), "df.json")
+	assert.Contains(t, st.Content().String(), `"filesystem"`)
+}
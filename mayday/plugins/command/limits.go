@@ -0,0 +1,84 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Limits constrains the resources available to a command. The zero value of
+// each field leaves the corresponding setting alone; Nice is a pointer so that
+// a command can set it back to 0.
+type Limits struct {
+	Nice       *int          `mapstructure:"nice"`         // scheduling priority, -20 (highest) to 19 (lowest)
+	IOClass    string        `mapstructure:"ionice_class"` // "realtime", "best-effort" or "idle"
+	IOLevel    int           `mapstructure:"ionice_level"` // priority within the IO class, 0 (highest) to 7
+	MemoryMB   int           `mapstructure:"memory_mb"`    // address space limit, in MiB
+	CPUSeconds int           `mapstructure:"cpu_seconds"`  // CPU time limit, in seconds
+	User       string        `mapstructure:"user"`         // unprivileged user to run as (only when mayday runs as root)
+	Timeout    time.Duration `mapstructure:"timeout"`      // kill the command after this long
+}
+
+// Merge returns l with every field that is set in override replaced
+func (l Limits) Merge(override Limits) Limits {
+	if override.Nice != nil {
+		l.Nice = override.Nice
+	}
+	if override.IOClass != "" {
+		l.IOClass = override.IOClass
+		l.IOLevel = override.IOLevel
+	}
+	if override.MemoryMB != 0 {
+		l.MemoryMB = override.MemoryMB
+	}
+	if override.CPUSeconds != 0 {
+		l.CPUSeconds = override.CPUSeconds
+	}
+	if override.User != "" {
+		l.User = override.User
+	}
+	if override.Timeout != 0 {
+		l.Timeout = override.Timeout
+	}
+	return l
+}
+
+// empty reports whether l applies no priority or resource limit
+func (l Limits) empty() bool {
+	return l.Nice == nil && l.IOClass == "" && l.MemoryMB == 0 && l.CPUSeconds == 0
+}
+
+// timeout returns the configured timeout, or defaultTimeout
+func (l Limits) timeout() time.Duration {
+	if l.Timeout > 0 {
+		return l.Timeout
+	}
+	return defaultTimeout
+}
+
+// credential looks up the user a command should be dropped to. It returns nil
+// when no user is configured or mayday is not running as root.
+func (l Limits) credential() (*syscall.Credential, error) {
+	if l.User == "" || os.Geteuid() != 0 {
+		return nil, nil
+	}
+
+	u, err := user.Lookup(l.User)
+	if err != nil {
+		return nil, fmt.Errorf("could not find user %q: %v", l.User, err)
+	}
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	gid, err := strconv.ParseUint(u.Gid, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty group list drops root's supplementary groups
+	return &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid), Groups: []uint32{}}, nil
+}
@@ -0,0 +1,82 @@
+//go:build linux
+// +build linux
+
+package command
+
+import (
+	"fmt"
+	"os/exec"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	ioprioWhoProcess = 1
+	ioprioClassShift = 13
+)
+
+var ioClasses = map[string]int{
+	"realtime":    1,
+	"best-effort": 2,
+	"idle":        3,
+}
+
+// sysProcAttr places the command in its own process group, so the whole
+// group can be killed on timeout, and drops privileges if requested.
+func sysProcAttr(l Limits) (*syscall.SysProcAttr, error) {
+	cred, err := l.credential()
+	if err != nil {
+		return nil, err
+	}
+	return &syscall.SysProcAttr{Setpgid: true, Credential: cred}, nil
+}
+
+// applyLimits sets the priority and resource limits of a started process.
+// Anything the process forks from here on inherits them, but what it forks
+// before, in the instant between its start and this call, escapes them.
+func applyLimits(pid int, l Limits) error {
+	if l.Nice != nil {
+		if err := syscall.Setpriority(syscall.PRIO_PROCESS, pid, *l.Nice); err != nil {
+			return fmt.Errorf("could not set nice %d: %v", *l.Nice, err)
+		}
+	}
+
+	if l.IOClass != "" {
+		class, ok := ioClasses[l.IOClass]
+		if !ok {
+			return fmt.Errorf("unknown ionice class %q", l.IOClass)
+		}
+		prio := class<<ioprioClassShift | l.IOLevel
+		if _, _, errno := syscall.RawSyscall(syscall.SYS_IOPRIO_SET, ioprioWhoProcess, uintptr(pid), uintptr(prio)); errno != 0 {
+			return fmt.Errorf("could not set ionice %s: %v", l.IOClass, errno)
+		}
+	}
+
+	if l.MemoryMB > 0 {
+		if err := prlimit(pid, syscall.RLIMIT_AS, uint64(l.MemoryMB)<<20); err != nil {
+			return fmt.Errorf("could not limit memory to %dMiB: %v", l.MemoryMB, err)
+		}
+	}
+
+	if l.CPUSeconds > 0 {
+		if err := prlimit(pid, syscall.RLIMIT_CPU, uint64(l.CPUSeconds)); err != nil {
+			return fmt.Errorf("could not limit CPU time to %ds: %v", l.CPUSeconds, err)
+		}
+	}
+
+	return nil
+}
+
+func prlimit(pid int, resource int, limit uint64) error {
+	rlim := syscall.Rlimit{Cur: limit, Max: limit}
+	_, _, errno := syscall.RawSyscall6(syscall.SYS_PRLIMIT64, uintptr(pid), uintptr(resource), uintptr(unsafe.Pointer(&rlim)), 0, 0, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// killGroup kills the command and everything it started
+func killGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
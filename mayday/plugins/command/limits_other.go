@@ -0,0 +1,25 @@
+//go:build !linux
+// +build !linux
+
+package command
+
+import (
+	"log"
+	"os/exec"
+	"syscall"
+)
+
+func sysProcAttr(l Limits) (*syscall.SysProcAttr, error) {
+	return nil, nil
+}
+
+func applyLimits(pid int, l Limits) error {
+	if !l.empty() {
+		log.Printf("resource limits are only supported on linux")
+	}
+	return nil
+}
+
+func killGroup(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
@@ -0,0 +1,271 @@
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// oneline splits a line of "ip -o" output into fields, dropping the "\"
+// that separates what would otherwise be continuation lines
+func oneline(l string) []string {
+	return strings.Fields(strings.Replace(l, `\`, " ", -1))
+}
+
+// index parses the "2:" that starts a line of "ip -o addr/link" output
+func index(f string) (int, error) {
+	return strconv.Atoi(strings.TrimSuffix(f, ":"))
+}
+
+type Address struct {
+	Index      int               `json:"index"`
+	Interface  string            `json:"interface"`
+	Family     string            `json:"family"` // inet or inet6
+	Address    string            `json:"address"`
+	Flags      []string          `json:"flags"`      // e.g. dynamic, noprefixroute
+	Attributes map[string]string `json:"attributes"` // brd, scope, label, valid_lft, ...
+}
+
+// addrKeys are the keywords of "ip addr" output that are followed by a
+// value; anything else is a flag or the address label
+var addrKeys = map[string]bool{
+	"brd": true, "scope": true, "peer": true, "valid_lft": true, "preferred_lft": true,
+	"metric": true, "proto": true, "anycast": true,
+}
+
+// IPAddr parses the output of ip -o addr show
+func IPAddr(output []byte) (interface{}, error) {
+	var addrs []Address
+	for _, l := range lines(output) {
+		fields := oneline(l)
+		if len(fields) < 4 {
+			continue
+		}
+		i, err := index(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("bad interface index in %q", l)
+		}
+		a := Address{
+			Index:      i,
+			Interface:  fields[1],
+			Family:     fields[2],
+			Address:    fields[3],
+			Flags:      []string{},
+			Attributes: make(map[string]string),
+		}
+		rest := fields[4:]
+		for j := 0; j < len(rest); j++ {
+			switch {
+			case addrKeys[rest[j]] && j+1 < len(rest):
+				a.Attributes[rest[j]] = rest[j+1]
+				j++
+			case rest[j] == a.Interface || strings.HasPrefix(rest[j], a.Interface+":"):
+				a.Attributes["label"] = rest[j]
+			default:
+				a.Flags = append(a.Flags, rest[j])
+			}
+		}
+		addrs = append(addrs, a)
+	}
+	return addrs, nil
+}
+
+type Link struct {
+	Index      int               `json:"index"`
+	Name       string            `json:"name"`
+	Flags      []string          `json:"flags"`
+	LinkType   string            `json:"link_type"` // e.g. ether, loopback, none
+	Address    string            `json:"address,omitempty"`
+	Broadcast  string            `json:"broadcast,omitempty"`
+	Attributes map[string]string `json:"attributes"` // mtu, qdisc, state, ...
+}
+
+// IPLink parses the output of ip -o link show
+func IPLink(output []byte) (interface{}, error) {
+	var links []Link
+	for _, l := range lines(output) {
+		fields := oneline(l)
+		if len(fields) < 3 {
+			continue
+		}
+		i, err := index(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("bad interface index in %q", l)
+		}
+		link := Link{
+			Index:      i,
+			Name:       strings.TrimSuffix(fields[1], ":"),
+			Flags:      strings.Split(strings.Trim(fields[2], "<>"), ","),
+			Attributes: make(map[string]string),
+		}
+		rest := fields[3:]
+		for j := 0; j < len(rest); j++ {
+			if strings.HasPrefix(rest[j], "link/") {
+				link.LinkType = strings.TrimPrefix(rest[j], "link/")
+				if j+1 < len(rest) && !strings.HasPrefix(rest[j+1], "brd") {
+					link.Address = rest[j+1]
+					j++
+				}
+				continue
+			}
+			if j+1 == len(rest) {
+				link.Attributes[rest[j]] = ""
+				continue
+			}
+			if rest[j] == "brd" && link.LinkType != "" {
+				link.Broadcast = rest[j+1]
+			} else {
+				link.Attributes[rest[j]] = rest[j+1]
+			}
+			j++
+		}
+		links = append(links, link)
+	}
+	return links, nil
+}
+
+type Route struct {
+	Type        string            `json:"type"` // unicast, local, blackhole, unreachable, ...
+	Destination string            `json:"destination"`
+	Flags       []string          `json:"flags"`      // e.g. linkdown, onlink
+	Attributes  map[string]string `json:"attributes"` // via, dev, proto, scope, src, metric, ...
+}
+
+var routeTypes = map[string]bool{
+	"unicast": true, "local": true, "broadcast": true, "multicast": true,
+	"throw": true, "unreachable": true, "prohibit": true, "blackhole": true, "nat": true,
+	"anycast": true,
+}
+
+var routeFlags = map[string]bool{
+	"linkdown": true, "onlink": true, "dead": true, "pervasive": true, "offload": true,
+	"notify": true,
+}
+
+// IPRoute parses the output of ip -o route show
+func IPRoute(output []byte) (interface{}, error) {
+	var routes []Route
+	for _, l := range lines(output) {
+		fields := oneline(l)
+		if len(fields) == 0 {
+			continue
+		}
+		r := Route{Type: "unicast", Flags: []string{}, Attributes: make(map[string]string)}
+		if routeTypes[fields[0]] {
+			r.Type = fields[0]
+			fields = fields[1:]
+		}
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("missing destination in %q", l)
+		}
+		r.Destination = fields[0]
+		rest := fields[1:]
+		for j := 0; j < len(rest); j++ {
+			if routeFlags[rest[j]] || j+1 == len(rest) {
+				r.Flags = append(r.Flags, rest[j])
+				continue
+			}
+			r.Attributes[rest[j]] = rest[j+1]
+			j++
+		}
+		routes = append(routes, r)
+	}
+	return routes, nil
+}
+
+type Chain struct {
+	Name       string `json:"name"`
+	Policy     string `json:"policy,omitempty"` // only built in chains have a policy
+	Packets    string `json:"packets,omitempty"`
+	Bytes      string `json:"bytes,omitempty"`
+	References int    `json:"references"`
+	Rules      []Rule `json:"rules"`
+}
+
+// Rule is a rule of an iptables chain. Counters are kept as printed, as
+// iptables abbreviates them (e.g. "12K").
+type Rule struct {
+	Packets     string `json:"packets"`
+	Bytes       string `json:"bytes"`
+	Target      string `json:"target,omitempty"`
+	Protocol    string `json:"protocol"`
+	Options     string `json:"options,omitempty"`
+	In          string `json:"in"`
+	Out         string `json:"out"`
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Match       string `json:"match,omitempty"` // everything after destination
+}
+
+var (
+	chainRe  = regexp.MustCompile(`^Chain (\S+) \((?:policy (\S+) (\S+) packets, (\S+) bytes|(\d+) references)\)`)
+	optionRe = regexp.MustCompile(`^(--|!?-?f)$`)
+)
+
+// IPTables parses the output of iptables -vnL or ip6tables -vnL
+func IPTables(output []byte) (interface{}, error) {
+	var chains []*Chain
+	var cur *Chain
+	for _, l := range lines(output) {
+		if m := chainRe.FindStringSubmatch(l); m != nil {
+			cur = &Chain{Name: m[1], Policy: m[2], Packets: m[3], Bytes: m[4], Rules: []Rule{}}
+			cur.References, _ = strconv.Atoi(m[5])
+			chains = append(chains, cur)
+			continue
+		}
+		fields := strings.Fields(l)
+		if cur == nil || len(fields) == 0 || fields[0] == "pkts" {
+			continue
+		}
+		r, err := rule(fields)
+		if err != nil {
+			return nil, fmt.Errorf("%s in %q", err, l)
+		}
+		cur.Rules = append(cur.Rules, r)
+	}
+	return chains, nil
+}
+
+// rule parses the fields of a rule line. The target is empty for rules that
+// only count packets, and ip6tables leaves the options column blank, so
+// columns are found relative to source and destination, which -n always
+// prints as addresses with a prefix length.
+func rule(fields []string) (Rule, error) {
+	var r Rule
+	s := -1
+	for i := 2; i+1 < len(fields); i++ {
+		if strings.Contains(fields[i], "/") && strings.Contains(fields[i+1], "/") {
+			s = i
+			break
+		}
+	}
+	if s < 5 {
+		return r, fmt.Errorf("unrecognized rule")
+	}
+
+	r.Packets = fields[0]
+	r.Bytes = fields[1]
+	r.In = fields[s-2]
+	r.Out = fields[s-1]
+	r.Source = fields[s]
+	r.Destination = fields[s+1]
+	r.Match = strings.Join(fields[s+2:], " ")
+
+	mid := fields[2 : s-2]
+	switch len(mid) {
+	case 3:
+		r.Target, r.Protocol, r.Options = mid[0], mid[1], mid[2]
+	case 2:
+		if optionRe.MatchString(mid[1]) {
+			r.Protocol, r.Options = mid[0], mid[1]
+		} else {
+			r.Target, r.Protocol = mid[0], mid[1]
+		}
+	case 1:
+		r.Protocol = mid[0]
+	default:
+		return r, fmt.Errorf("unrecognized rule")
+	}
+	return r, nil
+}
@@ -0,0 +1,303 @@
+// Package parse turns the text output of well known commands into structures
+// that can be stored as JSON next to the raw output.
+package parse
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parser parses the output of a command
+type Parser func(output []byte) (interface{}, error)
+
+// Lookup returns the parser for a command line, or nil if the output of the
+// command is not understood
+func Lookup(args []string) Parser {
+	if len(args) == 0 {
+		return nil
+	}
+
+	switch args[0] {
+	case "df":
+		return DF
+	case "free":
+		return Free
+	case "lsmod":
+		return Lsmod
+	case "ps":
+		return PS
+	case "systemctl":
+		if hasArg(args, "list-units") {
+			return SystemctlUnits
+		}
+	case "ip":
+		if !hasArg(args, "-o") {
+			return nil
+		}
+		switch {
+		case hasArg(args, "addr"):
+			return IPAddr
+		case hasArg(args, "link"):
+			return IPLink
+		case hasArg(args, "route"):
+			return IPRoute
+		}
+	case "iptables", "ip6tables":
+		if hasFlags(args, "vnL") {
+			return IPTables
+		}
+	}
+	return nil
+}
+
+func hasArg(args []string, arg string) bool {
+	for _, a := range args[1:] {
+		if a == arg {
+			return true
+		}
+	}
+	return false
+}
+
+// hasFlags reports whether every short flag in flags is given, possibly
+// combined as in "-vnL"
+func hasFlags(args []string, flags string) bool {
+	var given string
+	for _, a := range args[1:] {
+		if strings.HasPrefix(a, "-") && !strings.HasPrefix(a, "--") {
+			given += a[1:]
+		}
+	}
+	for _, f := range flags {
+		if !strings.ContainsRune(given, f) {
+			return false
+		}
+	}
+	return true
+}
+
+func lines(output []byte) []string {
+	var ls []string
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		ls = append(ls, scanner.Text())
+	}
+	return ls
+}
+
+// value converts a table cell to a number when it is one
+func value(s string) interface{} {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+	return s
+}
+
+// splitN splits a line into n whitespace separated fields, the last of which
+// holds the rest of the line
+func splitN(line string, n int) []string {
+	var fields []string
+	rest := strings.TrimSpace(line)
+	for len(fields) < n-1 && rest != "" {
+		i := strings.IndexAny(rest, " \t")
+		if i < 0 {
+			break
+		}
+		fields = append(fields, rest[:i])
+		rest = strings.TrimLeft(rest[i:], " \t")
+	}
+	if rest != "" {
+		fields = append(fields, rest)
+	}
+	return fields
+}
+
+// DFEntry is a row of df output, keyed by lowercased column name (e.g.
+// "1k-blocks", "iused", "mounted on")
+type DFEntry map[string]interface{}
+
+// DF parses the output of df, with or without -i
+func DF(output []byte) (interface{}, error) {
+	ls := lines(output)
+	if len(ls) == 0 || !strings.HasPrefix(ls[0], "Filesystem") {
+		return nil, fmt.Errorf("missing df header")
+	}
+
+	header := strings.Fields(strings.Replace(ls[0], "Mounted on", "Mounted_on", 1))
+	var entries []DFEntry
+	for _, l := range ls[1:] {
+		fields := splitN(l, len(header))
+		if len(fields) != len(header) {
+			continue
+		}
+		e := make(DFEntry)
+		for i, h := range header {
+			e[strings.ToLower(strings.Replace(h, "_", " ", 1))] = value(fields[i])
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// Free parses the output of free into a map of row ("Mem", "Swap") to
+// column ("total", "used", ...) to value
+func Free(output []byte) (interface{}, error) {
+	ls := lines(output)
+	if len(ls) == 0 {
+		return nil, fmt.Errorf("missing free header")
+	}
+
+	header := strings.Fields(ls[0])
+	result := make(map[string]map[string]int64)
+	for _, l := range ls[1:] {
+		parts := strings.SplitN(l, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		name := strings.TrimSpace(parts[0])
+		columns := header
+		if name == "-/+ buffers/cache" {
+			// older versions of free add a row with only used and free
+			columns = []string{"used", "free"}
+		}
+		row := make(map[string]int64)
+		for i, f := range strings.Fields(parts[1]) {
+			if i >= len(columns) {
+				break
+			}
+			v, err := strconv.ParseInt(f, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("bad value %q for %s %s", f, name, columns[i])
+			}
+			row[columns[i]] = v
+		}
+		result[name] = row
+	}
+	return result, nil
+}
+
+type Module struct {
+	Name   string   `json:"name"`
+	Size   int64    `json:"size"`
+	Used   int      `json:"used"`
+	UsedBy []string `json:"used_by"`
+}
+
+// Lsmod parses the output of lsmod
+func Lsmod(output []byte) (interface{}, error) {
+	ls := lines(output)
+	if len(ls) == 0 || !strings.HasPrefix(ls[0], "Module") {
+		return nil, fmt.Errorf("missing lsmod header")
+	}
+
+	var modules []Module
+	for _, l := range ls[1:] {
+		fields := strings.Fields(l)
+		if len(fields) < 3 {
+			continue
+		}
+		m := Module{Name: fields[0], UsedBy: []string{}}
+		m.Size, _ = strconv.ParseInt(fields[1], 10, 64)
+		m.Used, _ = strconv.Atoi(fields[2])
+		if len(fields) > 3 {
+			m.UsedBy = strings.Split(strings.TrimSuffix(fields[3], ","), ",")
+		}
+		modules = append(modules, m)
+	}
+	return modules, nil
+}
+
+type Process struct {
+	User    string  `json:"user"`
+	PID     int     `json:"pid"`
+	CPU     float64 `json:"cpu_percent"`
+	Mem     float64 `json:"mem_percent"`
+	VSZ     int64   `json:"vsz_kb"`
+	RSS     int64   `json:"rss_kb"`
+	TTY     string  `json:"tty"`
+	Stat    string  `json:"stat"`
+	Start   string  `json:"start"`
+	Time    string  `json:"time"`
+	Depth   int     `json:"depth"` // nesting in the process forest (ps f)
+	Command string  `json:"command"`
+}
+
+var psHeader = []string{"USER", "PID", "%CPU", "%MEM", "VSZ", "RSS", "TTY", "STAT", "START", "TIME", "COMMAND"}
+
+// PS parses BSD style "ps u" output, optionally as a forest (ps fauxwww)
+func PS(output []byte) (interface{}, error) {
+	ls := lines(output)
+	if len(ls) == 0 || strings.Join(strings.Fields(ls[0]), " ") != strings.Join(psHeader, " ") {
+		return nil, fmt.Errorf("unrecognized ps header")
+	}
+
+	var procs []Process
+	for _, l := range ls[1:] {
+		fields := splitN(l, len(psHeader))
+		if len(fields) != len(psHeader) {
+			continue
+		}
+		p := Process{
+			User:  fields[0],
+			TTY:   fields[6],
+			Stat:  fields[7],
+			Start: fields[8],
+			Time:  fields[9],
+		}
+		p.PID, _ = strconv.Atoi(fields[1])
+		p.CPU, _ = strconv.ParseFloat(fields[2], 64)
+		p.Mem, _ = strconv.ParseFloat(fields[3], 64)
+		p.VSZ, _ = strconv.ParseInt(fields[4], 10, 64)
+		p.RSS, _ = strconv.ParseInt(fields[5], 10, 64)
+
+		// forest output draws the tree before the command, four columns
+		// per level (" \_ ", " |   \_ ", ...); splitN has already eaten
+		// the leading space
+		cmd := fields[10]
+		p.Command = strings.TrimLeft(cmd, ` |\_`)
+		if tree := len(cmd) - len(p.Command); tree > 0 {
+			p.Depth = (tree + 1) / 4
+		}
+		procs = append(procs, p)
+	}
+	return procs, nil
+}
+
+type Unit struct {
+	Unit        string `json:"unit"`
+	Load        string `json:"load"`
+	Active      string `json:"active"`
+	Sub         string `json:"sub"`
+	Description string `json:"description"`
+}
+
+// SystemctlUnits parses the output of systemctl list-units
+func SystemctlUnits(output []byte) (interface{}, error) {
+	ls := lines(output)
+	if len(ls) == 0 || !strings.HasPrefix(strings.TrimSpace(ls[0]), "UNIT") {
+		return nil, fmt.Errorf("missing systemctl header")
+	}
+
+	var units []Unit
+	for _, l := range ls[1:] {
+		// the unit table ends at the first blank line, before the legend
+		if strings.TrimSpace(l) == "" {
+			break
+		}
+		// failed units are marked with a bullet
+		l = strings.TrimLeft(strings.TrimSpace(l), "●* ")
+		fields := splitN(l, 5)
+		if len(fields) < 4 {
+			continue
+		}
+		u := Unit{Unit: fields[0], Load: fields[1], Active: fields[2], Sub: fields[3]}
+		if len(fields) == 5 {
+			u.Description = fields[4]
+		}
+		units = append(units, u)
+	}
+	return units, nil
+}
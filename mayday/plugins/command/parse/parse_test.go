@@ -0,0 +1,186 @@
+package parse
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fixture parses a captured output from testdata with the parser Lookup
+// returns for args
+func fixture(t *testing.T, name string, args ...string) interface{} {
+	output, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := Lookup(args)
+	if p == nil {
+		t.Fatalf("no parser for %q", args)
+	}
+	parsed, err := p(output)
+	if err != nil {
+		t.Fatalf("parsing %s: %v", name, err)
+	}
+
+	// everything parsed must be representable as JSON
+	if _, err := json.Marshal(parsed); err != nil {
+		t.Fatalf("marshalling %s: %v", name, err)
+	}
+	return parsed
+}
+
+func TestLookup(t *testing.T) {
+	assert.NotNil(t, Lookup([]string{"iptables", "-v", "-n", "-L"}))
+	assert.Nil(t, Lookup([]string{"iptables-save"}))
+	assert.Nil(t, Lookup([]string{"iptables", "-L"}))
+	assert.Nil(t, Lookup([]string{"ip", "addr", "show"}))
+	assert.Nil(t, Lookup([]string{"systemctl", "status", "etcd.service"}))
+	assert.Nil(t, Lookup([]string{"hostname"}))
+	assert.Nil(t, Lookup(nil))
+}
+
+func TestDF(t *testing.T) {
+	entries := fixture(t, "df_-al.txt", "df", "-al").([]DFEntry)
+	assert.Len(t, entries, 6)
+	assert.Equal(t, entries[2]["filesystem"], "/dev/sda9")
+	assert.Equal(t, entries[2]["1k-blocks"], int64(26214172))
+	assert.Equal(t, entries[2]["use%"], "22%")
+	assert.Equal(t, entries[2]["mounted on"], "/")
+	assert.Equal(t, entries[4]["use%"], "-")
+
+	inodes := fixture(t, "df_-ali.txt", "df", "-ali").([]DFEntry)
+	assert.Len(t, inodes, 5)
+	assert.Equal(t, inodes[3]["filesystem"], "/dev/mapper/usr")
+	assert.Equal(t, inodes[3]["iused"], int64(16426))
+}
+
+func TestFree(t *testing.T) {
+	free := fixture(t, "free_-m.txt", "free", "-m").(map[string]map[string]int64)
+	assert.Equal(t, free["Mem"]["total"], int64(7859))
+	assert.Equal(t, free["Mem"]["buff/cache"], int64(4314))
+	assert.Equal(t, free["Mem"]["available"], int64(4989))
+	assert.Equal(t, free["Swap"]["total"], int64(0))
+
+	old := fixture(t, "free_-m_procps3.txt", "free", "-m").(map[string]map[string]int64)
+	assert.Equal(t, old["Mem"]["cached"], int64(4913))
+	assert.Equal(t, old["-/+ buffers/cache"], map[string]int64{"used": 2111, "free": 5872})
+}
+
+func TestLsmod(t *testing.T) {
+	modules := fixture(t, "lsmod.txt", "lsmod").([]Module)
+	assert.Len(t, modules, 7)
+	assert.Equal(t, modules[0], Module{Name: "xt_nat", Size: 16384, Used: 12, UsedBy: []string{}})
+	assert.Equal(t, modules[2].UsedBy, []string{"nf_nat_ipv4", "xt_nat", "nf_nat_masquerade_ipv4"})
+}
+
+func TestPS(t *testing.T) {
+	procs := fixture(t, "ps_fauxwww.txt", "ps", "fauxwww").([]Process)
+	assert.Len(t, procs, 7)
+
+	assert.Equal(t, procs[1].Command, "[rcu_gp]")
+	assert.Equal(t, procs[1].Depth, 1)
+
+	assert.Equal(t, procs[3].PID, 842)
+	assert.Equal(t, procs[3].RSS, int64(116872))
+	assert.Equal(t, procs[3].Stat, "Ssl")
+	assert.Equal(t, procs[3].Depth, 0)
+
+	assert.Equal(t, procs[6].User, "core")
+	assert.Equal(t, procs[6].CPU, 2.5)
+	assert.Equal(t, procs[6].Depth, 3)
+	assert.Equal(t, procs[6].Command, "/usr/bin/etcd --name node1")
+}
+
+func TestSystemctlUnits(t *testing.T) {
+	units := fixture(t, "systemctl_list-units_-a.txt", "systemctl", "list-units", "-a").([]Unit)
+	assert.Len(t, units, 6)
+	assert.Equal(t, units[0], Unit{Unit: "-.mount", Load: "loaded", Active: "active", Sub: "mounted", Description: "Root Mount"})
+	assert.Equal(t, units[2].Unit, "docker.service")
+	assert.Equal(t, units[2].Active, "failed")
+	assert.Equal(t, units[4].Load, "not-found")
+}
+
+func TestIPAddr(t *testing.T) {
+	addrs := fixture(t, "ip_-o_addr_show.txt", "ip", "-o", "addr", "show").([]Address)
+	assert.Len(t, addrs, 6)
+
+	assert.Equal(t, addrs[0].Address, "127.0.0.1/8")
+	assert.Equal(t, addrs[0].Attributes["label"], "lo")
+	assert.Equal(t, addrs[1].Family, "inet6")
+	assert.Equal(t, addrs[1].Attributes["scope"], "host")
+
+	assert.Equal(t, addrs[2].Index, 2)
+	assert.Equal(t, addrs[2].Flags, []string{"dynamic", "noprefixroute"})
+	assert.Equal(t, addrs[2].Attributes["brd"], "10.7.35.255")
+	assert.Equal(t, addrs[2].Attributes["valid_lft"], "2943sec")
+
+	assert.Equal(t, addrs[3].Flags, []string{"secondary"})
+	assert.Equal(t, addrs[3].Attributes["label"], "eth0:vip")
+}
+
+func TestIPLink(t *testing.T) {
+	links := fixture(t, "ip_-o_link_show.txt", "ip", "-o", "link", "show").([]Link)
+	assert.Len(t, links, 5)
+
+	assert.Equal(t, links[0].Name, "lo")
+	assert.Equal(t, links[0].LinkType, "loopback")
+	assert.Equal(t, links[0].Attributes["mtu"], "65536")
+
+	assert.Equal(t, links[2].Flags, []string{"NO-CARRIER", "BROADCAST", "MULTICAST", "UP"})
+	assert.Equal(t, links[2].Attributes["state"], "DOWN")
+
+	assert.Equal(t, links[3].Name, "veth1a2b3c4@if4")
+	assert.Equal(t, links[3].Address, "6a:1b:2c:3d:4e:5f")
+	assert.Equal(t, links[3].Broadcast, "ff:ff:ff:ff:ff:ff")
+	assert.Equal(t, links[3].Attributes["master"], "cni0")
+	assert.Equal(t, links[3].Attributes["link-netnsid"], "0")
+}
+
+func TestIPRoute(t *testing.T) {
+	routes := fixture(t, "ip_-o_route_show.txt", "ip", "-o", "route", "show").([]Route)
+	assert.Len(t, routes, 7)
+
+	assert.Equal(t, routes[0].Destination, "default")
+	assert.Equal(t, routes[0].Attributes, map[string]string{
+		"via": "10.7.32.1", "dev": "eth0", "proto": "dhcp", "src": "10.7.33.12", "metric": "1024",
+	})
+	assert.Equal(t, routes[1].Attributes, map[string]string{"dev": "flannel.1"})
+	assert.Equal(t, routes[4].Flags, []string{"linkdown"})
+	assert.Equal(t, routes[5].Type, "blackhole")
+	assert.Equal(t, routes[5].Destination, "10.2.14.0/24")
+	assert.Equal(t, routes[6].Type, "unreachable")
+}
+
+func TestIPTables(t *testing.T) {
+	chains := fixture(t, "iptables_-vnL.txt", "iptables", "-vnL").([]*Chain)
+	assert.Len(t, chains, 5)
+
+	assert.Equal(t, chains[0].Name, "INPUT")
+	assert.Equal(t, chains[0].Policy, "ACCEPT")
+	assert.Equal(t, chains[0].Packets, "1204K")
+	assert.Len(t, chains[0].Rules, 1)
+
+	forward := chains[1]
+	assert.Len(t, forward.Rules, 3)
+	assert.Equal(t, forward.Rules[1].Out, "docker0")
+	assert.Equal(t, forward.Rules[1].Match, "ctstate RELATED,ESTABLISHED")
+	// a rule without a target only counts packets
+	assert.Equal(t, forward.Rules[2], Rule{
+		Packets: "17", Bytes: "1020", Protocol: "tcp", Options: "--", In: "eth0", Out: "*",
+		Source: "10.0.0.0/8", Destination: "0.0.0.0/0", Match: "tcp dpt:22",
+	})
+
+	assert.Len(t, chains[2].Rules, 0)
+	assert.Equal(t, chains[3].References, 1)
+	assert.Equal(t, chains[4].Rules[0].Match, "/* kubernetes firewall for dropping marked packets */ mark match 0x8000/0x8000")
+
+	v6 := fixture(t, "ip6tables_-vnL.txt", "ip6tables", "-vnL").([]*Chain)
+	assert.Len(t, v6, 3)
+	assert.Equal(t, v6[0].Rules[0].Target, "ACCEPT")
+	assert.Equal(t, v6[0].Rules[0].In, "lo")
+	assert.Equal(t, v6[0].Rules[1].Protocol, "ipv6-icmp")
+}
@@ -0,0 +1,140 @@
+package command
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/mayday/mayday/plugins/command/parse"
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+const (
+	defaultInterval = time.Second
+)
+
+// Sampler runs a command repeatedly in the background, storing every run as a
+// timestamped section of a single output file
+type Sampler struct {
+	args     []string
+	link     string
+	samples  int           // how many times to run the command
+	interval time.Duration // time between the start of consecutive runs
+	content  *bytes.Buffer // the timestamped series, populated by Start()
+	parsed   []Sample      // the parsed output of each run, if the command is well known
+	once     sync.Once
+	done     chan struct{} // closed once sampling has finished
+	Output   string        // name of command output file
+	Limits   Limits        // resource limits applied to every run
+}
+
+func NewSampler(args []string, link string, samples int, interval time.Duration) *Sampler {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	s := &Sampler{
+		args:     args,
+		link:     link,
+		samples:  samples,
+		interval: interval,
+		content:  new(bytes.Buffer),
+		done:     make(chan struct{}),
+	}
+	s.Output = "/mayday_commands/" + strings.Join(s.args, "_")
+	return s
+}
+
+// Start begins sampling in the background. No run is started after
+// deadline, and a run still going at deadline is killed, unless deadline is
+// the zero time.
+func (s *Sampler) Start(deadline time.Time) {
+	s.once.Do(func() {
+		go s.run(deadline)
+	})
+}
+
+func (s *Sampler) run(deadline time.Time) {
+	defer close(s.done)
+
+	log.Printf("Sampling command %q %d times every %v", strings.Join(s.args, " "), s.samples, s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for i := 0; i < s.samples; i++ {
+		if i > 0 {
+			<-ticker.C
+		}
+		now := time.Now()
+		if !deadline.IsZero() && !now.Before(deadline) {
+			fmt.Fprintf(s.content, "=== stopped after %d of %d samples: deadline reached ===\n", i, s.samples)
+			return
+		}
+
+		c := New(s.args, "")
+		c.Limits = s.Limits
+		if left := deadline.Sub(now); !deadline.IsZero() && left < c.Limits.timeout() {
+			c.Limits.Timeout = left
+		}
+		err := c.Run()
+
+		if c.parsed != nil {
+			s.parsed = append(s.parsed, Sample{Time: now, Output: c.parsed})
+		}
+
+		fmt.Fprintf(s.content, "=== %s ===\n", now.UTC().Format(time.RFC3339Nano))
+		s.content.ReadFrom(c.Content())
+		if err != nil {
+			fmt.Fprintf(s.content, "=== error: %v ===\n", err)
+		}
+	}
+}
+
+// Sample is the parsed output of one run of a sampled command
+type Sample struct {
+	Time   time.Time   `json:"time"`
+	Output interface{} `json:"output"`
+}
+
+// Structured returns the parsed output of every run, to be stored next to
+// the raw output, or nil if the command is not well known
+func (s *Sampler) Structured() *Structured {
+	if parse.Lookup(s.args) == nil {
+		return nil
+	}
+	return newStructured(s.Name(), s.link, func() (interface{}, error) {
+		s.Wait()
+		return s.parsed, nil
+	})
+}
+
+// Wait blocks until sampling has finished
+func (s *Sampler) Wait() {
+	s.Start(time.Time{})
+	<-s.done
+}
+
+func (s *Sampler) Name() string {
+	return s.Output
+}
+
+func (s *Sampler) Args() []string {
+	return s.args
+}
+
+func (s *Sampler) Content() *bytes.Buffer {
+	s.Wait()
+	return s.content
+}
+
+func (s *Sampler) Link() string {
+	return s.link
+}
+
+func (s *Sampler) Header() *tar.Header {
+	return tarable.Header(s.Content(), s.Name())
+}
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"log"
+
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+// Structured is the parsed output of a command, stored as JSON next to the
+// raw output
+type Structured struct {
+	name    string // name of the raw output
+	link    string // link to the raw output
+	parse   func() (interface{}, error)
+	content *bytes.Buffer
+}
+
+func newStructured(name string, link string, parse func() (interface{}, error)) *Structured {
+	return &Structured{name: name, link: link, parse: parse}
+}
+
+func (s *Structured) Content() *bytes.Buffer {
+	if s.content != nil {
+		return s.content
+	}
+
+	var out interface{}
+	parsed, err := s.parse()
+	if err != nil {
+		log.Printf("could not parse output of %s: %s", s.name, err)
+		out = map[string]string{"error": err.Error()}
+	} else {
+		out = parsed
+	}
+
+	b, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		log.Printf("error marshalling parsed output of %s: %s", s.name, err)
+		b = []byte("json marshal error")
+	}
+	s.content = bytes.NewBuffer(b)
+	return s.content
+}
+
+func (s *Structured) Header() *tar.Header {
+	return tarable.Header(s.Content(), s.Name())
+}
+
+func (s *Structured) Name() string {
+	return s.name + ".json"
+}
+
+func (s *Structured) Link() string {
+	if s.link == "" {
+		return ""
+	}
+	return s.link + ".json"
+}
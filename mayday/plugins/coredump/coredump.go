@@ -0,0 +1,363 @@
+// Package coredump lists the coredumps systemd-coredump recorded, with their
+// backtraces, and collects the core files themselves in danger mode.
+package coredump
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/coreos/mayday/mayday/tarable"
+	"github.com/spf13/viper"
+)
+
+const outputDir = "/coredumps/"
+
+const (
+	defaultDirectory  = "/var/lib/systemd/coredump"
+	defaultMaxCoreMB  = 64
+	defaultMaxTotalMB = 256
+
+	// messageID identifies the journal entries systemd-coredump writes
+	messageID = "fc2e22bc6ee647b6b90729ab34a250b1"
+)
+
+type Config struct {
+	Directory  string `mapstructure:"directory"`    // where core files are stored
+	MaxCoreMB  int    `mapstructure:"max_core_mb"`  // larger core files aren't collected
+	MaxTotalMB int    `mapstructure:"max_total_mb"` // total size of collected core files
+}
+
+func (c Config) withDefaults() Config {
+	if c.Directory == "" {
+		c.Directory = defaultDirectory
+	}
+	if c.MaxCoreMB == 0 {
+		c.MaxCoreMB = defaultMaxCoreMB
+	}
+	if c.MaxTotalMB == 0 {
+		c.MaxTotalMB = defaultMaxTotalMB
+	}
+	return c
+}
+
+// Coredump is a crash recorded by systemd-coredump
+type Coredump struct {
+	Time       time.Time `json:"time"`
+	PID        int       `json:"pid"`
+	UID        int       `json:"uid"`
+	Signal     int       `json:"signal,omitempty"`
+	SignalName string    `json:"signal_name,omitempty"`
+	Command    string    `json:"command,omitempty"`
+	Executable string    `json:"executable,omitempty"`
+	Unit       string    `json:"unit,omitempty"`
+	File       string    `json:"file,omitempty"`      // the core file, when stored on disk
+	Size       int64     `json:"size,omitempty"`      // of the (compressed) core file
+	Present    bool      `json:"present"`             // the core file still exists
+	Backtrace  string    `json:"-"`                   // as logged to the journal
+	Collected  string    `json:"collected,omitempty"` // the core file's path in the archive
+	Skipped    string    `json:"skipped,omitempty"`   // why the core file wasn't collected
+}
+
+// readJournal returns the coredump entries of the journal, as journalctl -o
+// json writes them. Fields larger than 64K (such as a core stored in the
+// journal) are left out by journalctl.
+var readJournal = func() ([]byte, error) {
+	return exec.Command("journalctl", "--no-pager", "--utc", "-o", "json", "MESSAGE_ID="+messageID).Output()
+}
+
+var signalNames = map[int]string{
+	3: "SIGQUIT", 4: "SIGILL", 5: "SIGTRAP", 6: "SIGABRT", 7: "SIGBUS", 8: "SIGFPE",
+	11: "SIGSEGV", 24: "SIGXCPU", 25: "SIGXFSZ", 31: "SIGSYS",
+}
+
+// field returns a journal field as a string. journalctl writes fields that
+// aren't valid UTF-8 as arrays of bytes.
+func field(e map[string]interface{}, name string) string {
+	switch v := e[name].(type) {
+	case string:
+		return v
+	case []interface{}:
+		b := make([]byte, 0, len(v))
+		for _, c := range v {
+			if n, ok := c.(float64); ok {
+				b = append(b, byte(n))
+			}
+		}
+		return string(b)
+	}
+	return ""
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// parseJournal parses the output of readJournal
+func parseJournal(output []byte) ([]*Coredump, error) {
+	var dumps []*Coredump
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var e map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return dumps, err
+		}
+		d := &Coredump{
+			PID:        atoi(field(e, "COREDUMP_PID")),
+			UID:        atoi(field(e, "COREDUMP_UID")),
+			Signal:     atoi(field(e, "COREDUMP_SIGNAL")),
+			Command:    field(e, "COREDUMP_COMM"),
+			Executable: field(e, "COREDUMP_EXE"),
+			Unit:       field(e, "COREDUMP_UNIT"),
+			File:       field(e, "COREDUMP_FILENAME"),
+		}
+		// the time of the crash, or else of the journal entry
+		ts := field(e, "COREDUMP_TIMESTAMP")
+		if ts == "" {
+			ts = field(e, "__REALTIME_TIMESTAMP")
+		}
+		if usec, err := strconv.ParseInt(ts, 10, 64); err == nil {
+			d.Time = time.Unix(0, usec*int64(time.Microsecond)).UTC()
+		}
+		if msg := field(e, "MESSAGE"); strings.Contains(msg, "Stack trace of thread") {
+			d.Backtrace = msg
+		}
+		dumps = append(dumps, d)
+	}
+	return dumps, scanner.Err()
+}
+
+// parseFilename reads what systemd-coredump encodes in the name of a core
+// file: core.COMM.UID.BOOT_ID.PID.TIMESTAMP, followed by the compression
+func parseFilename(name string) (*Coredump, bool) {
+	for _, ext := range []string{".xz", ".lz4", ".zst"} {
+		name = strings.TrimSuffix(name, ext)
+	}
+	if !strings.HasPrefix(name, "core.") {
+		return nil, false
+	}
+	parts := strings.Split(strings.TrimPrefix(name, "core."), ".")
+	if len(parts) < 5 {
+		return nil, false
+	}
+	n := len(parts)
+	usec, err := strconv.ParseInt(parts[n-1], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &Coredump{
+		Command: strings.Join(parts[:n-4], "."),
+		UID:     atoi(parts[n-4]),
+		PID:     atoi(parts[n-2]),
+		Time:    time.Unix(0, usec*int64(time.Microsecond)).UTC(),
+	}, true
+}
+
+// List returns the coredumps in the journal and in the coredump directory,
+// oldest first
+func List(c Config) []*Coredump {
+	c = c.withDefaults()
+
+	output, err := readJournal()
+	if err != nil {
+		log.Printf("error reading coredumps from the journal: %s", err)
+	}
+	dumps, err := parseJournal(output)
+	if err != nil {
+		log.Printf("error parsing coredumps from the journal: %s", err)
+	}
+
+	byFile := make(map[string]*Coredump)
+	for _, d := range dumps {
+		if d.File != "" {
+			byFile[d.File] = d
+		}
+	}
+
+	files, err := ioutil.ReadDir(c.Directory)
+	if err != nil && !os.IsNotExist(err) {
+		log.Printf("error listing coredumps: %s", err)
+	}
+	for _, fi := range files {
+		if !fi.Mode().IsRegular() {
+			continue
+		}
+		path := filepath.Join(c.Directory, fi.Name())
+		d, ok := byFile[path]
+		if !ok {
+			// e.g. the journal has been rotated since
+			if d, ok = parseFilename(fi.Name()); !ok {
+				continue
+			}
+			d.File = path
+			dumps = append(dumps, d)
+		}
+		d.Present = true
+		d.Size = fi.Size()
+	}
+
+	for _, d := range dumps {
+		d.SignalName = signalNames[d.Signal]
+	}
+	sort.SliceStable(dumps, func(i, j int) bool { return dumps[i].Time.Before(dumps[j].Time) })
+	return dumps
+}
+
+// name identifies a coredump in the archive
+func (d *Coredump) name() string {
+	if d.File != "" {
+		return filepath.Base(d.File)
+	}
+	return fmt.Sprintf("%s.%d.%d", d.Command, d.PID, d.Time.Unix())
+}
+
+// Outputs returns the coredump index and backtraces, and in danger mode the
+// core files that fit within the size limits
+func Outputs(dumps []*Coredump, c Config) []tarable.Tarable {
+	c = c.withDefaults()
+	outputs := []tarable.Tarable{
+		tarable.NewOutput(outputDir, "index", "coredumps", func() []byte { return indexText(dumps) }),
+		tarable.NewOutput(outputDir, "index.json", "", func() []byte { return indexJSON(dumps) }),
+	}
+
+	maxCore := int64(c.MaxCoreMB) * 1024 * 1024
+	budget := int64(c.MaxTotalMB) * 1024 * 1024
+	// the most recent crashes matter most
+	for i := len(dumps) - 1; i >= 0; i-- {
+		d := dumps[i]
+		if d.Backtrace != "" {
+			bt := d.Backtrace
+			outputs = append(outputs, tarable.NewOutput(outputDir, "backtraces/"+d.name()+".txt", "", func() []byte { return []byte(bt) }))
+		}
+
+		switch {
+		case !d.Present:
+			continue
+		case !viper.GetBool("danger"):
+			d.Skipped = "core files are only collected with --danger"
+		case d.Size > maxCore:
+			d.Skipped = fmt.Sprintf("larger than %d MB", c.MaxCoreMB)
+		case d.Size > budget:
+			d.Skipped = fmt.Sprintf("core files would exceed %d MB in total", c.MaxTotalMB)
+		default:
+			budget -= d.Size
+			core := &Core{path: d.File, name: "/coredumps/cores/" + d.name(), limit: d.Size}
+			d.Collected = core.name
+			outputs = append(outputs, core)
+		}
+	}
+	return outputs
+}
+
+func indexText(dumps []*Coredump) []byte {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tPID\tUID\tSIG\tCOREFILE\tSIZE\tEXE\tUNIT")
+	for _, d := range dumps {
+		sig := d.SignalName
+		if sig == "" {
+			sig = strconv.Itoa(d.Signal)
+		}
+		corefile := "missing"
+		switch {
+		case d.File == "":
+			corefile = "none"
+		case d.Collected != "":
+			corefile = "collected"
+		case d.Present:
+			corefile = "present"
+		}
+		exe := d.Executable
+		if exe == "" {
+			exe = d.Command
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%d\t%s\t%s\n",
+			d.Time.Format(time.RFC3339), d.PID, d.UID, sig, corefile, d.Size, exe, d.Unit)
+	}
+	w.Flush()
+	return b.Bytes()
+}
+
+func indexJSON(dumps []*Coredump) []byte {
+	if dumps == nil {
+		dumps = []*Coredump{}
+	}
+	b, err := json.MarshalIndent(dumps, "", "  ")
+	if err != nil {
+		log.Printf("error marshalling coredumps: %s", err)
+		return []byte("json marshal error")
+	}
+	return b
+}
+
+// Core is a core file, stored as systemd-coredump compressed it. It is copied
+// from disk when the archive is written, up to the size it was collected at.
+type Core struct {
+	path  string
+	name  string
+	limit int64
+}
+
+func (c *Core) Open() (io.ReadCloser, error) {
+	log.Printf("Collecting core file: %q", c.path)
+	f, err := os.Open(c.path)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(f, c.limit), f}, nil
+}
+
+// Content reads the core file into memory, which the archive avoids by
+// streaming it from Open
+func (c *Core) Content() *bytes.Buffer {
+	var b bytes.Buffer
+	r, err := c.Open()
+	if err != nil {
+		log.Printf("error reading core file: %s", err)
+		return &b
+	}
+	defer r.Close()
+	if _, err := b.ReadFrom(r); err != nil {
+		log.Printf("error reading core file: %s", err)
+	}
+	return &b
+}
+
+func (c *Core) Header() *tar.Header {
+	var size int64
+	if fi, err := os.Stat(c.path); err != nil {
+		log.Printf("error reading core file: %s", err)
+	} else {
+		size = fi.Size()
+	}
+	if size > c.limit {
+		size = c.limit
+	}
+	return &tar.Header{Name: c.name, Mode: 0666, Size: size, ModTime: time.Now()}
+}
+
+func (c *Core) Name() string {
+	return c.name
+}
+
+func (c *Core) Link() string {
+	return ""
+}
@@ -0,0 +1,156 @@
+package coredump
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coreos/mayday/mayday/tarable"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+const backtrace = `Process 1234 (etcd) of user 232 dumped core.
+
+Stack trace of thread 1234:
+#0  0x000000000045e2a1 n/a (/usr/bin/etcd)`
+
+// setup creates a coredump directory holding the given core files, and a
+// journal with an entry for the first one and one whose core is gone
+func setup(t *testing.T, cores map[string]int) (string, func()) {
+	dir, err := ioutil.TempDir("", "mayday-coredump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, size := range cores {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), make([]byte, size), 0640); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries := []map[string]interface{}{
+		{
+			"COREDUMP_PID": "1234", "COREDUMP_UID": "232", "COREDUMP_SIGNAL": "11",
+			"COREDUMP_COMM": "etcd", "COREDUMP_EXE": "/usr/bin/etcd", "COREDUMP_UNIT": "etcd.service",
+			"COREDUMP_TIMESTAMP": "1488326400000000",
+			"COREDUMP_FILENAME":  filepath.Join(dir, "core.etcd.232.6c1f7a3b9c7d4e0f8a1b2c3d4e5f6a7b.1234.1488326400000000.lz4"),
+			"MESSAGE":            backtrace,
+		},
+		{
+			"COREDUMP_PID": "99", "COREDUMP_UID": "0", "COREDUMP_SIGNAL": "6",
+			"COREDUMP_COMM": "crashd", "COREDUMP_EXE": "/usr/sbin/crashd",
+			"__REALTIME_TIMESTAMP": "1488240000000000",
+			"COREDUMP_FILENAME":    filepath.Join(dir, "core.crashd.0.6c1f7a3b9c7d4e0f8a1b2c3d4e5f6a7b.99.1488240000000000.xz"),
+			// journalctl writes fields that aren't UTF-8 as byte arrays
+			"MESSAGE": []byte{'b', 'y', 't', 'e', 's'},
+		},
+	}
+	var output []byte
+	for _, e := range entries {
+		if b, ok := e["MESSAGE"].([]byte); ok {
+			var ints []int
+			for _, c := range b {
+				ints = append(ints, int(c))
+			}
+			e["MESSAGE"] = ints
+		}
+		line, _ := json.Marshal(e)
+		output = append(append(output, line...), '\n')
+	}
+	readJournal = func() ([]byte, error) { return output, nil }
+
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func TestList(t *testing.T) {
+	dir, cleanup := setup(t, map[string]int{
+		"core.etcd.232.6c1f7a3b9c7d4e0f8a1b2c3d4e5f6a7b.1234.1488326400000000.lz4":   100,
+		"core.my.app.500.6c1f7a3b9c7d4e0f8a1b2c3d4e5f6a7b.4321.1488412800000000.zst": 200,
+		"not-a-core": 10,
+	})
+	defer cleanup()
+
+	dumps := List(Config{Directory: dir})
+	assert.Len(t, dumps, 3)
+
+	// the journal entry of a deleted core
+	assert.Equal(t, dumps[0].Command, "crashd")
+	assert.Equal(t, dumps[0].SignalName, "SIGABRT")
+	assert.Equal(t, dumps[0].Time, time.Date(2017, 2, 28, 0, 0, 0, 0, time.UTC))
+	assert.False(t, dumps[0].Present)
+	assert.Empty(t, dumps[0].Backtrace)
+
+	etcd := dumps[1]
+	assert.Equal(t, etcd.Executable, "/usr/bin/etcd")
+	assert.Equal(t, etcd.Unit, "etcd.service")
+	assert.Equal(t, etcd.SignalName, "SIGSEGV")
+	assert.True(t, etcd.Present)
+	assert.EqualValues(t, etcd.Size, 100)
+	assert.Equal(t, etcd.Backtrace, backtrace)
+
+	// only found on disk, after the journal was rotated
+	app := dumps[2]
+	assert.Equal(t, app.Command, "my.app")
+	assert.Equal(t, app.UID, 500)
+	assert.Equal(t, app.PID, 4321)
+	assert.Equal(t, app.Time, time.Date(2017, 3, 2, 0, 0, 0, 0, time.UTC))
+	assert.True(t, app.Present)
+}
+
+func TestOutputs(t *testing.T) {
+	dir, cleanup := setup(t, map[string]int{
+		"core.etcd.232.6c1f7a3b9c7d4e0f8a1b2c3d4e5f6a7b.1234.1488326400000000.lz4": 100,
+		"core.big.0.6c1f7a3b9c7d4e0f8a1b2c3d4e5f6a7b.1.1488412800000000.zst":       2 * 1024 * 1024,
+	})
+	defer cleanup()
+	c := Config{Directory: dir, MaxCoreMB: 1}
+
+	viper.Set("danger", false)
+	outputs := Outputs(List(c), c)
+	var ns []string
+	for _, o := range outputs {
+		ns = append(ns, o.Name())
+	}
+	assert.Equal(t, ns, []string{
+		"/coredumps/index",
+		"/coredumps/index.json",
+		"/coredumps/backtraces/core.etcd.232.6c1f7a3b9c7d4e0f8a1b2c3d4e5f6a7b.1234.1488326400000000.lz4.txt",
+	})
+	assert.Contains(t, outputs[0].Content().String(), "2017-03-01T00:00:00Z  1234  232  SIGSEGV  present")
+	assert.Contains(t, outputs[0].Content().String(), "missing")
+	assert.Contains(t, outputs[1].Content().String(), "only collected with --danger")
+
+	viper.Set("danger", true)
+	defer viper.Set("danger", false)
+	outputs = Outputs(List(c), c)
+	core := outputs[len(outputs)-1]
+	assert.Equal(t, core.Name(), "/coredumps/cores/core.etcd.232.6c1f7a3b9c7d4e0f8a1b2c3d4e5f6a7b.1234.1488326400000000.lz4")
+	assert.Equal(t, core.Content().Len(), 100)
+
+	// the core is streamed up to the size it was collected at
+	f, err := os.OpenFile(filepath.Join(dir, "core.etcd.232.6c1f7a3b9c7d4e0f8a1b2c3d4e5f6a7b.1234.1488326400000000.lz4"), os.O_APPEND|os.O_WRONLY, 0644)
+	assert.Nil(t, err)
+	f.Write(make([]byte, 50))
+	f.Close()
+	assert.Equal(t, core.Header().Size, int64(100))
+	r, err := core.(tarable.Streamer).Open()
+	assert.Nil(t, err)
+	b, _ := ioutil.ReadAll(r)
+	r.Close()
+	assert.Len(t, b, 100)
+
+	index := outputs[1].Content().String()
+	assert.Contains(t, index, `"collected": "/coredumps/cores/core.etcd`)
+	assert.Contains(t, index, `"skipped": "larger than 1 MB"`)
+	assert.Contains(t, outputs[0].Content().String(), "collected")
+}
+
+func TestParseFilename(t *testing.T) {
+	_, ok := parseFilename("core.etcd.232.bootid.1234.notatime.xz")
+	assert.False(t, ok)
+	_, ok = parseFilename("vmcore")
+	assert.False(t, ok)
+}
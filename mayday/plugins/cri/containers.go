@@ -0,0 +1,66 @@
+package cri
+
+import (
+	"time"
+
+	"github.com/coreos/mayday/mayday/containers"
+	"github.com/coreos/mayday/mayday/plugins/cri/v1alpha2"
+	"github.com/spf13/viper"
+)
+
+// Containers describes the containers the way every runtime's are
+func Containers(cs []*Container) []*containers.Container {
+	var described []*containers.Container
+	for _, c := range cs {
+		d := &containers.Container{
+			Runtime:  "cri",
+			Id:       c.Id,
+			Pod:      c.pod,
+			State:    state(c.State),
+			ExitCode: int(c.ExitCode),
+			Created:  nanoseconds(c.CreatedAt),
+			Started:  nanoseconds(c.StartedAt),
+			Exited:   nanoseconds(c.FinishedAt),
+			Dump:     "cri/containers/" + c.Id + ".json",
+		}
+		if m := c.GetMetadata(); m != nil {
+			d.Name = m.Name
+			// the kubelet creates a new container for every restart
+			d.RestartCount = int(m.Attempt)
+		}
+		if i := c.GetImage(); i != nil {
+			d.Image = i.Image
+		}
+		if c.podIP != "" {
+			// containers share the network of their pod
+			d.Networks = []containers.Network{{Name: "pod", IPv4: c.podIP}}
+		}
+		for _, m := range c.Mounts {
+			d.Mounts = append(d.Mounts, containers.Mount{Source: m.HostPath, Destination: m.ContainerPath, ReadOnly: m.Readonly})
+		}
+		if viper.GetBool("danger") && c.LogPath != "" {
+			d.Logs = "cri/containers/" + c.Id + ".log"
+		}
+		described = append(described, d)
+	}
+	return described
+}
+
+func state(s v1alpha2.ContainerState) string {
+	switch s {
+	case v1alpha2.ContainerState_CONTAINER_CREATED:
+		return containers.Created
+	case v1alpha2.ContainerState_CONTAINER_RUNNING:
+		return containers.Running
+	case v1alpha2.ContainerState_CONTAINER_EXITED:
+		return containers.Exited
+	}
+	return containers.Unknown
+}
+
+func nanoseconds(ns int64) *time.Time {
+	if ns == 0 {
+		return nil
+	}
+	return containers.Time(time.Unix(0, ns))
+}
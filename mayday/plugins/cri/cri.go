@@ -0,0 +1,441 @@
+package cri
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/coreos/mayday/mayday/plugins/cri/v1alpha2"
+	"github.com/coreos/mayday/mayday/scrub"
+	"github.com/coreos/mayday/mayday/tarable"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const outputDir = "/cri/"
+
+const (
+	// apiTimeout bounds all the calls to the runtime
+	apiTimeout  = 2 * time.Minute
+	dialTimeout = time.Second
+)
+
+// defaultSockets are where containerd and CRI-O serve the CRI
+var defaultSockets = []string{
+	"/run/containerd/containerd.sock",
+	"/var/run/crio/crio.sock",
+}
+
+type Config struct {
+	Socket string `mapstructure:"socket"` // the known sockets are tried when empty
+	Logs   Logs   `mapstructure:"logs"`   // collected with --danger
+}
+
+func (c Config) sockets() []string {
+	if c.Socket != "" {
+		return []string{c.Socket}
+	}
+	return defaultSockets
+}
+
+// dial connects to the CRI socket at path
+var dial = func(path string) (*grpc.ClientConn, error) {
+	return grpc.Dial(path, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(dialTimeout),
+		grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", addr, timeout)
+		}))
+}
+
+// apis are the versions of the CRI, newest first. Their messages are the same
+// on the wire, only the package of their services differs.
+var apis = []struct {
+	name    string
+	runtime func(*grpc.ClientConn) runtimeService
+	image   func(*grpc.ClientConn) imageService
+}{
+	{
+		"v1",
+		func(cc *grpc.ClientConn) runtimeService { return v1Runtime{cc} },
+		func(cc *grpc.ClientConn) imageService { return v1Image{cc} },
+	},
+	{
+		"v1alpha2",
+		func(cc *grpc.ClientConn) runtimeService { return v1alpha2.NewRuntimeServiceClient(cc) },
+		func(cc *grpc.ClientConn) imageService { return v1alpha2.NewImageServiceClient(cc) },
+	},
+}
+
+// client is a connection to a CRI runtime, through the newest API it serves
+type client struct {
+	conn    *grpc.ClientConn
+	runtime runtimeService
+	image   imageService
+}
+
+// connect returns a connection to the first socket serving the CRI, e.g. not
+// a containerd without its CRI plugin, and the runtime's version
+func connect(ctx context.Context, c Config) (*client, *v1alpha2.VersionResponse, error) {
+	err := fmt.Errorf("no CRI socket found in %s", strings.Join(c.sockets(), ", "))
+	for _, s := range c.sockets() {
+		if _, statErr := os.Stat(s); statErr != nil {
+			continue
+		}
+		conn, dialErr := dial(s)
+		if dialErr != nil {
+			err = fmt.Errorf("%s: %s", s, dialErr)
+			continue
+		}
+		for _, api := range apis {
+			rs := api.runtime(conn)
+			version, versionErr := rs.Version(ctx, &v1alpha2.VersionRequest{})
+			if versionErr != nil {
+				err = fmt.Errorf("%s: %s", s, versionErr)
+				continue
+			}
+			log.Printf("Using the %s CRI of %s %s at %s", api.name, version.RuntimeName, version.RuntimeVersion, s)
+			return &client{conn: conn, runtime: rs, image: api.image(conn)}, version, nil
+		}
+		conn.Close()
+	}
+	return nil, nil, err
+}
+
+// GetContainers lists the pod sandboxes, containers and images of the CRI
+// runtime, and collects the logs of the containers with --danger. The
+// environments of the containers are scrubbed by env.
+func GetContainers(c Config, env *scrub.Scrubber) ([]*Container, []*tarable.Output, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), apiTimeout)
+	defer cancel()
+
+	client, version, err := connect(ctx, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer client.conn.Close()
+	rs := client.runtime
+
+	outputs := []*tarable.Output{
+		tarable.NewOutput(outputDir, "version.json", "cri_version", func() []byte { return marshal(version) }),
+	}
+
+	pods, err := podStatuses(ctx, rs)
+	if err != nil {
+		return nil, outputs, err
+	}
+	outputs = append(outputs, tarable.NewOutput(outputDir, "pods", "cri_pods", func() []byte { return podsText(pods) }))
+	for _, p := range pods {
+		p := p
+		outputs = append(outputs, tarable.NewOutput(outputDir, "pods/"+p.Status.Id+".json", "", func() []byte {
+			status := *p.Status
+			status.Annotations = scrubAnnotations(status.Annotations, env)
+			return marshal(podJSON{&status, status.State.String()})
+		}))
+	}
+
+	containers, err := containerStatuses(ctx, rs, env)
+	if err != nil {
+		return nil, outputs, err
+	}
+	for _, c := range containers {
+		for _, p := range pods {
+			if n := p.Status.GetNetwork(); p.Status.Id == c.pod && n != nil {
+				c.podIP = n.Ip
+			}
+		}
+	}
+	outputs = append(outputs, tarable.NewOutput(outputDir, "containers", "cri_containers", func() []byte { return containersText(containers, pods) }))
+
+	if images, err := client.image.ListImages(ctx, &v1alpha2.ListImagesRequest{}); err != nil {
+		log.Printf("error listing CRI images: %s", err)
+	} else {
+		outputs = append(outputs,
+			tarable.NewOutput(outputDir, "images", "cri_images", func() []byte { return imagesText(images.Images) }),
+			tarable.NewOutput(outputDir, "images.json", "", func() []byte { return marshal(images.Images) }),
+		)
+	}
+
+	outputs = append(outputs, getLogs(containers, c.Logs)...)
+	return containers, outputs, nil
+}
+
+// podStatuses lists the pod sandboxes, with the status of each
+func podStatuses(ctx context.Context, rs runtimeService) ([]*v1alpha2.PodSandboxStatusResponse, error) {
+	resp, err := rs.ListPodSandbox(ctx, &v1alpha2.ListPodSandboxRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var pods []*v1alpha2.PodSandboxStatusResponse
+	for _, p := range resp.Items {
+		status, err := rs.PodSandboxStatus(ctx, &v1alpha2.PodSandboxStatusRequest{PodSandboxId: p.Id})
+		if err != nil || status.Status == nil {
+			// e.g. removed since it was listed
+			log.Printf("error getting the status of pod sandbox %s: %v", p.Id, err)
+			status = &v1alpha2.PodSandboxStatusResponse{Status: &v1alpha2.PodSandboxStatus{
+				Id: p.Id, Metadata: p.Metadata, State: p.State, CreatedAt: p.CreatedAt, Labels: p.Labels, Annotations: p.Annotations,
+			}}
+		}
+		pods = append(pods, status)
+	}
+	return pods, nil
+}
+
+// containerStatuses lists the containers, with the status and the
+// configuration of each
+func containerStatuses(ctx context.Context, rs runtimeService, env *scrub.Scrubber) ([]*Container, error) {
+	resp, err := rs.ListContainers(ctx, &v1alpha2.ListContainersRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var containers []*Container
+	for _, c := range resp.Containers {
+		status, err := rs.ContainerStatus(ctx, &v1alpha2.ContainerStatusRequest{ContainerId: c.Id, Verbose: true})
+		if err != nil || status.Status == nil {
+			log.Printf("error getting the status of container %s: %v", c.Id, err)
+			status = &v1alpha2.ContainerStatusResponse{Status: &v1alpha2.ContainerStatus{
+				Id: c.Id, Metadata: c.Metadata, State: c.State, CreatedAt: c.CreatedAt, Image: c.Image, ImageRef: c.ImageRef,
+				Labels: c.Labels, Annotations: c.Annotations,
+			}}
+		}
+		containers = append(containers, &Container{ContainerStatus: status.Status, pod: c.PodSandboxId, info: status.Info, env: env})
+	}
+	return containers, nil
+}
+
+// Container is a container of the CRI runtime, stored with its status and
+// the configuration the runtime gives in verbose mode
+type Container struct {
+	*v1alpha2.ContainerStatus
+	pod     string // the id of its pod sandbox
+	podIP   string
+	info    map[string]string
+	content *bytes.Buffer
+	env     *scrub.Scrubber
+}
+
+// containerJSON is a container as it is stored, with its state readable and
+// its configuration as JSON rather than strings
+type containerJSON struct {
+	*v1alpha2.ContainerStatus
+	PodSandboxId string                     `json:"pod_sandbox_id"`
+	State        string                     `json:"state"`
+	Info         map[string]json.RawMessage `json:"info,omitempty"`
+}
+
+type podJSON struct {
+	*v1alpha2.PodSandboxStatus
+	State string `json:"state"`
+}
+
+func (c *Container) Content() *bytes.Buffer {
+	if c.content == nil {
+		status := *c.ContainerStatus
+		status.Annotations = scrubAnnotations(status.Annotations, c.env)
+		c.content = bytes.NewBuffer(marshal(containerJSON{
+			ContainerStatus: &status,
+			PodSandboxId:    c.pod,
+			State:           c.State.String(),
+			Info:            scrubInfo(c.info, c.env),
+		}))
+	}
+	return c.content
+}
+
+func (c *Container) Header() *tar.Header {
+	return tarable.Header(c.Content(), c.Name())
+}
+
+func (c *Container) Name() string {
+	return "/cri/containers/" + c.Id + ".json"
+}
+
+func (c *Container) Link() string {
+	return ""
+}
+
+// scrubAnnotations copies the annotations of a pod sandbox or container,
+// redacting the ones named like credentials and scrubbing the configurations
+// kept as JSON, like kubectl.kubernetes.io/last-applied-configuration
+func scrubAnnotations(annotations map[string]string, env *scrub.Scrubber) map[string]string {
+	if len(annotations) == 0 {
+		return annotations
+	}
+	scrubbed := make(map[string]string, len(annotations))
+	for k, v := range annotations {
+		if scrub.SecretName(k) {
+			scrubbed[k] = scrub.Redacted
+			continue
+		}
+		scrubbed[k] = env.JSON(v)
+	}
+	return scrubbed
+}
+
+// scrubInfo parses the verbose information of a container, which runtimes
+// give as JSON strings, scrubbing the environment variables it holds
+func scrubInfo(info map[string]string, env *scrub.Scrubber) map[string]json.RawMessage {
+	if len(info) == 0 {
+		return nil
+	}
+	scrubbed := make(map[string]json.RawMessage)
+	for k, v := range info {
+		var parsed interface{}
+		if err := json.Unmarshal([]byte(v), &parsed); err != nil {
+			// not JSON, e.g. a pid
+			parsed = v
+		}
+		b, err := json.Marshal(scrubEnv(parsed, env))
+		if err != nil {
+			continue
+		}
+		scrubbed[k] = b
+	}
+	return scrubbed
+}
+
+// scrubEnv scrubs the environment variables found in v, either in the
+// NAME=VALUE form of an OCI runtime spec ("env") or as the key and value pairs
+// of a CRI container config ("envs")
+func scrubEnv(v interface{}, env *scrub.Scrubber) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, sub := range t {
+			list, isList := sub.([]interface{})
+			switch {
+			case isList && strings.ToLower(k) == "env":
+				var vars []string
+				for _, e := range list {
+					s, _ := e.(string)
+					vars = append(vars, s)
+				}
+				t[k] = env.Env(vars)
+			case isList && strings.ToLower(k) == "envs":
+				for _, e := range list {
+					if kv, ok := e.(map[string]interface{}); ok {
+						name, _ := kv["key"].(string)
+						value, _ := kv["value"].(string)
+						kv["value"] = env.Value(name, value)
+					}
+				}
+			default:
+				t[k] = scrubEnv(sub, env)
+			}
+		}
+	case []interface{}:
+		for i, sub := range t {
+			t[i] = scrubEnv(sub, env)
+		}
+	}
+	return v
+}
+
+func podsText(pods []*v1alpha2.PodSandboxStatusResponse) []byte {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "POD ID\tCREATED\tSTATE\tNAME\tNAMESPACE\tATTEMPT\tIP")
+	for _, p := range pods {
+		s := p.Status
+		m := s.GetMetadata()
+		if m == nil {
+			m = &v1alpha2.PodSandboxMetadata{}
+		}
+		ip := "-"
+		if n := s.GetNetwork(); n != nil && n.Ip != "" {
+			ip = n.Ip
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\n", short(s.Id), created(s.CreatedAt), s.State, m.Name, m.Namespace, m.Attempt, ip)
+	}
+	w.Flush()
+	return b.Bytes()
+}
+
+func containersText(containers []*Container, pods []*v1alpha2.PodSandboxStatusResponse) []byte {
+	podNames := make(map[string]string)
+	for _, p := range pods {
+		if m := p.Status.GetMetadata(); m != nil {
+			podNames[p.Status.Id] = m.Namespace + "/" + m.Name
+		}
+	}
+
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CONTAINER\tIMAGE\tCREATED\tSTATE\tEXIT\tNAME\tATTEMPT\tPOD ID\tPOD")
+	for _, c := range containers {
+		m := c.GetMetadata()
+		if m == nil {
+			m = &v1alpha2.ContainerMetadata{}
+		}
+		image := "-"
+		if i := c.GetImage(); i != nil && i.Image != "" {
+			image = i.Image
+		}
+		pod := podNames[c.pod]
+		if pod == "" {
+			pod = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%d\t%s\t%s\n",
+			short(c.Id), image, created(c.CreatedAt), strings.TrimPrefix(c.State.String(), "CONTAINER_"), c.ExitCode, m.Name, m.Attempt, short(c.pod), pod)
+	}
+	w.Flush()
+	return b.Bytes()
+}
+
+func imagesText(images []*v1alpha2.Image) []byte {
+	type row struct{ repo, tag, id string }
+	var rows []row
+	sizes := make(map[string]uint64)
+	for _, i := range images {
+		sizes[i.Id] = i.Size
+		if len(i.RepoTags) == 0 {
+			rows = append(rows, row{"<none>", "<none>", i.Id})
+		}
+		for _, t := range i.RepoTags {
+			r := row{t, "<none>", i.Id}
+			if colon := strings.LastIndex(t, ":"); colon > strings.LastIndex(t, "/") {
+				r.repo, r.tag = t[:colon], t[colon+1:]
+			}
+			rows = append(rows, r)
+		}
+	}
+	sort.SliceStable(rows, func(a, b int) bool { return rows[a].repo < rows[b].repo })
+
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "IMAGE\tTAG\tIMAGE ID\tSIZE")
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", r.repo, r.tag, short(strings.TrimPrefix(r.id, "sha256:")), sizes[r.id])
+	}
+	w.Flush()
+	return b.Bytes()
+}
+
+// short truncates ids the way crictl does
+func short(id string) string {
+	if len(id) > 13 {
+		return id[:13]
+	}
+	return id
+}
+
+func created(ns int64) string {
+	if ns == 0 {
+		return "-"
+	}
+	return time.Unix(0, ns).UTC().Format(time.RFC3339)
+}
+
+func marshal(v interface{}) []byte {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		log.Printf("error marshalling CRI data: %s", err)
+		return []byte("json marshal error")
+	}
+	return b
+}
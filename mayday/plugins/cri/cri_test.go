@@ -0,0 +1,333 @@
+package cri
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coreos/mayday/mayday/containers"
+	"github.com/coreos/mayday/mayday/plugins/cri/v1alpha2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// fakeServer is an in-process CRI runtime, implementing the methods mayday
+// calls
+type fakeServer struct {
+	v1alpha2.RuntimeServiceServer
+	v1alpha2.ImageServiceServer
+	pods       []*v1alpha2.PodSandboxStatus
+	containers []*v1alpha2.ContainerStatus
+	pod        map[string]string // the pod sandbox of each container
+	info       map[string]string // given for every container in verbose mode
+	images     []*v1alpha2.Image
+}
+
+func (f *fakeServer) Version(ctx context.Context, in *v1alpha2.VersionRequest) (*v1alpha2.VersionResponse, error) {
+	return &v1alpha2.VersionResponse{Version: "0.1.0", RuntimeName: "containerd", RuntimeVersion: "v1.2.6", RuntimeApiVersion: "v1alpha2"}, nil
+}
+
+func (f *fakeServer) ListPodSandbox(ctx context.Context, in *v1alpha2.ListPodSandboxRequest) (*v1alpha2.ListPodSandboxResponse, error) {
+	var items []*v1alpha2.PodSandbox
+	for _, p := range f.pods {
+		items = append(items, &v1alpha2.PodSandbox{Id: p.Id, Metadata: p.Metadata, State: p.State, CreatedAt: p.CreatedAt})
+	}
+	// removed before its status is asked for
+	items = append(items, &v1alpha2.PodSandbox{Id: "gone", State: v1alpha2.PodSandboxState_SANDBOX_NOTREADY})
+	return &v1alpha2.ListPodSandboxResponse{Items: items}, nil
+}
+
+func (f *fakeServer) PodSandboxStatus(ctx context.Context, in *v1alpha2.PodSandboxStatusRequest) (*v1alpha2.PodSandboxStatusResponse, error) {
+	for _, p := range f.pods {
+		if p.Id == in.PodSandboxId {
+			return &v1alpha2.PodSandboxStatusResponse{Status: p}, nil
+		}
+	}
+	return nil, errors.New("pod sandbox not found")
+}
+
+func (f *fakeServer) ListContainers(ctx context.Context, in *v1alpha2.ListContainersRequest) (*v1alpha2.ListContainersResponse, error) {
+	var cs []*v1alpha2.Container
+	for _, c := range f.containers {
+		cs = append(cs, &v1alpha2.Container{Id: c.Id, PodSandboxId: f.pod[c.Id], Metadata: c.Metadata, State: c.State})
+	}
+	return &v1alpha2.ListContainersResponse{Containers: cs}, nil
+}
+
+func (f *fakeServer) ContainerStatus(ctx context.Context, in *v1alpha2.ContainerStatusRequest) (*v1alpha2.ContainerStatusResponse, error) {
+	for _, c := range f.containers {
+		if c.Id == in.ContainerId {
+			resp := &v1alpha2.ContainerStatusResponse{Status: c}
+			if in.Verbose {
+				resp.Info = f.info
+			}
+			return resp, nil
+		}
+	}
+	return nil, errors.New("container not found")
+}
+
+func (f *fakeServer) ListImages(ctx context.Context, in *v1alpha2.ListImagesRequest) (*v1alpha2.ListImagesResponse, error) {
+	return &v1alpha2.ListImagesResponse{Images: f.images}, nil
+}
+
+// v1Server is a fakeServer serving the v1 API, which tells its version apart
+type v1Server struct {
+	*fakeServer
+}
+
+func (f v1Server) Version(ctx context.Context, in *v1alpha2.VersionRequest) (*v1alpha2.VersionResponse, error) {
+	v, err := f.fakeServer.Version(ctx, in)
+	v.RuntimeApiVersion = "v1"
+	return v, err
+}
+
+// v1Method serves a method of v1Server, decoding its request into a new
+// message
+func v1Method(name string, in func() interface{}, call func(f v1Server, ctx context.Context, in interface{}) (interface{}, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+			req := in()
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+			return call(srv.(v1Server), ctx, req)
+		},
+	}
+}
+
+// v1Services are the v1 runtime and image services, taking the same messages
+// as v1alpha2's
+func v1Services() []*grpc.ServiceDesc {
+	return []*grpc.ServiceDesc{{
+		ServiceName: "runtime.v1.RuntimeService",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{
+			v1Method("Version", func() interface{} { return new(v1alpha2.VersionRequest) }, func(f v1Server, ctx context.Context, in interface{}) (interface{}, error) {
+				return f.Version(ctx, in.(*v1alpha2.VersionRequest))
+			}),
+			v1Method("ListPodSandbox", func() interface{} { return new(v1alpha2.ListPodSandboxRequest) }, func(f v1Server, ctx context.Context, in interface{}) (interface{}, error) {
+				return f.ListPodSandbox(ctx, in.(*v1alpha2.ListPodSandboxRequest))
+			}),
+			v1Method("PodSandboxStatus", func() interface{} { return new(v1alpha2.PodSandboxStatusRequest) }, func(f v1Server, ctx context.Context, in interface{}) (interface{}, error) {
+				return f.PodSandboxStatus(ctx, in.(*v1alpha2.PodSandboxStatusRequest))
+			}),
+			v1Method("ListContainers", func() interface{} { return new(v1alpha2.ListContainersRequest) }, func(f v1Server, ctx context.Context, in interface{}) (interface{}, error) {
+				return f.ListContainers(ctx, in.(*v1alpha2.ListContainersRequest))
+			}),
+			v1Method("ContainerStatus", func() interface{} { return new(v1alpha2.ContainerStatusRequest) }, func(f v1Server, ctx context.Context, in interface{}) (interface{}, error) {
+				return f.ContainerStatus(ctx, in.(*v1alpha2.ContainerStatusRequest))
+			}),
+		},
+	}, {
+		ServiceName: "runtime.v1.ImageService",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{
+			v1Method("ListImages", func() interface{} { return new(v1alpha2.ListImagesRequest) }, func(f v1Server, ctx context.Context, in interface{}) (interface{}, error) {
+				return f.ListImages(ctx, in.(*v1alpha2.ListImagesRequest))
+			}),
+		},
+	}}
+}
+
+// serve runs f on a unix socket in dir, with both the v1 and v1alpha2 APIs
+// unless v1alpha2Only
+func serve(t *testing.T, f *fakeServer, dir string, v1alpha2Only bool) (string, func()) {
+	socket := filepath.Join(dir, "containerd.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	if !v1alpha2Only {
+		for _, desc := range v1Services() {
+			s.RegisterService(desc, v1Server{f})
+		}
+	}
+	v1alpha2.RegisterRuntimeServiceServer(s, f)
+	v1alpha2.RegisterImageServiceServer(s, f)
+	go s.Serve(l)
+	return socket, s.Stop
+}
+
+// lastApplied is the configuration kubectl keeps in an annotation of the pods
+// it applies, with their environments
+const lastApplied = `{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"kube-system"},` +
+	`"spec":{"containers":[{"name":"etcd","env":[{"name":"DB_PASSWORD","value":"hunter2"},{"name":"TZ","value":"UTC"}]}]}}` + "\n"
+
+func TestGetContainers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mayday-cri")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	logPath := filepath.Join(dir, "0.log")
+	ioutil.WriteFile(logPath, []byte("2019-05-01T10:00:00.1Z stdout F one\n2019-05-01T10:00:01.2Z stderr F two\n2019-05-01T10:00:02.3Z stdout F three\n"), 0644)
+
+	server := &fakeServer{
+		pods: []*v1alpha2.PodSandboxStatus{{
+			Id:        "8f9c3bb6a6d0e7f11e34bd6f3fbc5c6a4a0a3f7b92f61a11f1c6bb6a0e5c2d1b",
+			Metadata:  &v1alpha2.PodSandboxMetadata{Name: "etcd-node1", Namespace: "kube-system", Uid: "1234", Attempt: 1},
+			CreatedAt: 1556704800000000000,
+			Network:   &v1alpha2.PodSandboxNetworkStatus{Ip: "10.2.0.5"},
+			Annotations: map[string]string{
+				"kubectl.kubernetes.io/last-applied-configuration": lastApplied,
+				"example.com/api-token":                            "hunter2",
+				"kubernetes.io/config.source":                      "file",
+			},
+		}},
+		containers: []*v1alpha2.ContainerStatus{{
+			Id:        "c0ffee0123456789",
+			Metadata:  &v1alpha2.ContainerMetadata{Name: "etcd", Attempt: 3},
+			State:     v1alpha2.ContainerState_CONTAINER_EXITED,
+			CreatedAt: 1556704801000000000, StartedAt: 1556704802000000000, FinishedAt: 1556704803000000000,
+			ExitCode:    2,
+			Image:       &v1alpha2.ImageSpec{Image: "k8s.gcr.io/etcd:3.3.10"},
+			Mounts:      []*v1alpha2.Mount{{ContainerPath: "/var/lib/etcd", HostPath: "/var/lib/etcd"}, {ContainerPath: "/etc/kubernetes/pki/etcd", HostPath: "/etc/kubernetes/pki/etcd", Readonly: true}},
+			LogPath:     logPath,
+			Reason:      "Error",
+			Annotations: map[string]string{"kubectl.kubernetes.io/last-applied-configuration": lastApplied},
+		}},
+		pod: map[string]string{"c0ffee0123456789": "8f9c3bb6a6d0e7f11e34bd6f3fbc5c6a4a0a3f7b92f61a11f1c6bb6a0e5c2d1b"},
+		info: map[string]string{
+			"info": `{"pid": 0, "config": {"envs": [{"key": "ETCD_NAME", "value": "node1"}, {"key": "ETCDCTL_API", "value": "3"}]},
+				"runtimeSpec": {"process": {"args": ["etcd"], "env": ["PATH=/usr/bin", "ETCD_PEER_KEY=abc"]}}}`,
+			"pid": "1234",
+		},
+		images: []*v1alpha2.Image{
+			{Id: "sha256:2c4adeb21b4ff8ed3309d0e42b6b4ae39872399f7b37e0856e673b13c4aba13d", RepoTags: []string{"k8s.gcr.io/etcd:3.3.10"}, Size: 76159417},
+			{Id: "sha256:da86e6ba6ca197bf6bc5e9d900febd906b133eaa4750e6bed647b0fbe50ed43e", RepoTags: []string{"localhost:5000/pause:3.1"}, Size: 317164},
+		},
+	}
+	socket, stop := serve(t, server, dir, false)
+	defer stop()
+
+	viper.Set("danger", false)
+	cs, outputs, err := GetContainers(Config{Socket: socket}, nil)
+	assert.Nil(t, err)
+
+	byName := make(map[string]string)
+	var ns []string
+	for _, o := range outputs {
+		ns = append(ns, o.Name())
+		byName[o.Name()] = o.Content().String()
+	}
+	pod := "/cri/pods/8f9c3bb6a6d0e7f11e34bd6f3fbc5c6a4a0a3f7b92f61a11f1c6bb6a0e5c2d1b.json"
+	assert.Equal(t, ns, []string{"/cri/version.json", "/cri/pods", pod, "/cri/pods/gone.json", "/cri/containers", "/cri/images", "/cri/images.json"})
+	assert.Equal(t, outputs[0].Link(), "cri_version")
+	assert.Contains(t, byName["/cri/version.json"], `"runtime_name": "containerd"`)
+
+	assert.Equal(t, byName["/cri/pods"], `POD ID         CREATED               STATE             NAME        NAMESPACE    ATTEMPT  IP
+8f9c3bb6a6d0e  2019-05-01T10:00:00Z  SANDBOX_READY     etcd-node1  kube-system  1        10.2.0.5
+gone           -                     SANDBOX_NOTREADY                           0        -
+`)
+	assert.Contains(t, byName[pod], `"state": "SANDBOX_READY"`)
+	assert.Contains(t, byName[pod], `"ip": "10.2.0.5"`)
+	// annotations holding secrets are scrubbed
+	assert.Contains(t, byName[pod], `\"name\":\"DB_PASSWORD\",\"value\":\"scrubbed by mayday\"`)
+	assert.Contains(t, byName[pod], `\"name\":\"TZ\",\"value\":\"UTC\"`)
+	assert.Contains(t, byName[pod], `"example.com/api-token": "scrubbed by mayday"`)
+	assert.Contains(t, byName[pod], `"kubernetes.io/config.source": "file"`)
+	assert.NotContains(t, byName[pod], "hunter2")
+
+	assert.Equal(t, byName["/cri/containers"], `CONTAINER      IMAGE                   CREATED               STATE   EXIT  NAME  ATTEMPT  POD ID         POD
+c0ffee0123456  k8s.gcr.io/etcd:3.3.10  2019-05-01T10:00:01Z  EXITED  2     etcd  3        8f9c3bb6a6d0e  kube-system/etcd-node1
+`)
+	assert.Equal(t, byName["/cri/images"], `IMAGE                 TAG     IMAGE ID       SIZE
+k8s.gcr.io/etcd       3.3.10  2c4adeb21b4ff  76159417
+localhost:5000/pause  3.1     da86e6ba6ca19  317164
+`)
+
+	assert.Len(t, cs, 1)
+	assert.Equal(t, cs[0].Name(), "/cri/containers/c0ffee0123456789.json")
+	container := cs[0].Content().String()
+	assert.Contains(t, container, `"state": "CONTAINER_EXITED"`)
+	assert.Contains(t, container, `"reason": "Error"`)
+	assert.Contains(t, container, `"pod_sandbox_id": "8f9c3bb6a6d0e7f11e34bd6f3fbc5c6a4a0a3f7b92f61a11f1c6bb6a0e5c2d1b"`)
+	// the configuration is stored as JSON, with its environment scrubbed
+	assert.Contains(t, container, `"args": [`)
+	assert.Contains(t, container, `"PATH=/usr/bin"`)
+	assert.Contains(t, container, `"ETCD_PEER_KEY=scrubbed by mayday"`)
+	assert.NotContains(t, container, "node1")
+	assert.Contains(t, container, `\"name\":\"DB_PASSWORD\",\"value\":\"scrubbed by mayday\"`)
+	assert.NotContains(t, container, "hunter2")
+	assert.Contains(t, container, `"pid": 1234`)
+
+	// logs with --danger
+	viper.Set("danger", true)
+	defer viper.Set("danger", false)
+	_, outputs, err = GetContainers(Config{Socket: socket, Logs: Logs{Lines: 2}}, nil)
+	assert.Nil(t, err)
+	l := outputs[len(outputs)-1]
+	assert.Equal(t, l.Name(), "/cri/containers/c0ffee0123456789.log")
+	assert.Equal(t, l.Content().String(), "2019-05-01T10:00:01.2Z stderr F two\n2019-05-01T10:00:02.3Z stdout F three\n")
+
+	described := Containers(cs)
+	assert.Equal(t, described[0].Runtime, "cri")
+	assert.Equal(t, described[0].Name, "etcd")
+	assert.Equal(t, described[0].Pod, "8f9c3bb6a6d0e7f11e34bd6f3fbc5c6a4a0a3f7b92f61a11f1c6bb6a0e5c2d1b")
+	assert.Equal(t, described[0].State, "exited")
+	assert.Equal(t, described[0].RestartCount, 3)
+	assert.Equal(t, described[0].Exited.Unix(), int64(1556704803))
+	assert.Equal(t, described[0].Networks, []containers.Network{{Name: "pod", IPv4: "10.2.0.5"}})
+	assert.Equal(t, described[0].Mounts[1], containers.Mount{Source: "/etc/kubernetes/pki/etcd", Destination: "/etc/kubernetes/pki/etcd", ReadOnly: true})
+	assert.Equal(t, described[0].Logs, "cri/containers/c0ffee0123456789.log")
+}
+
+func TestConnect(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mayday-cri")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	defer func(s []string) { defaultSockets = s }(defaultSockets)
+	defaultSockets = []string{filepath.Join(dir, "missing.sock"), filepath.Join(dir, "containerd.sock")}
+	_, _, err = GetContainers(Config{}, nil)
+	assert.EqualError(t, err, fmt.Sprintf("no CRI socket found in %s", strings.Join(defaultSockets, ", ")))
+
+	// the first socket serving the CRI is used
+	for _, v1alpha2Only := range []bool{false, true} {
+		_, stop := serve(t, &fakeServer{}, dir, v1alpha2Only)
+		cs, outputs, err := GetContainers(Config{}, nil)
+		stop()
+		assert.Nil(t, err)
+		assert.Len(t, cs, 0)
+		assert.Contains(t, outputs[0].Content().String(), "containerd")
+		// v1 is used when served, v1alpha2 otherwise
+		if v1alpha2Only {
+			assert.Contains(t, outputs[0].Content().String(), `"runtime_api_version": "v1alpha2"`)
+		} else {
+			assert.Contains(t, outputs[0].Content().String(), `"runtime_api_version": "v1"`)
+		}
+	}
+}
+
+func TestTail(t *testing.T) {
+	f, err := ioutil.TempFile("", "mayday-cri")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	for i := 0; i < 10; i++ {
+		fmt.Fprintf(f, "2019-05-01T10:00:00Z stdout F %s\n", strings.Repeat(fmt.Sprint(i), 200))
+	}
+	f.Close()
+
+	// the size limit wins, without the line it cuts
+	b := tail(f.Name(), Logs{Lines: 5, MaxKB: 1})
+	lines := strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+	assert.Len(t, lines, 4)
+	assert.True(t, strings.HasSuffix(lines[3], strings.Repeat("9", 200)))
+
+	assert.Equal(t, strings.Count(string(tail(f.Name(), Logs{Lines: 2, MaxKB: 1024})), "\n"), 2)
+	assert.Contains(t, string(tail("/nonexistent", Logs{Lines: 2, MaxKB: 1})), "error reading /nonexistent")
+}
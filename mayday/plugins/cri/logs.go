@@ -0,0 +1,90 @@
+package cri
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/coreos/mayday/mayday/tarable"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultLogLines = 1000
+	defaultLogMaxKB = 1024
+)
+
+// Logs bounds how much of each container's log is collected
+type Logs struct {
+	Lines int `mapstructure:"lines"`  // the last lines of each log
+	MaxKB int `mapstructure:"max_kb"` // per container, older lines are dropped first
+}
+
+func (l Logs) withDefaults() Logs {
+	if l.Lines == 0 {
+		l.Lines = defaultLogLines
+	}
+	if l.MaxKB == 0 {
+		l.MaxKB = defaultLogMaxKB
+	}
+	return l
+}
+
+// getLogs reads the end of the log file of each container. Runtimes write
+// them in the CRI format, where every line already has its time and stream.
+func getLogs(containers []*Container, limits Logs) []*tarable.Output {
+	var logs []*tarable.Output
+	if !viper.GetBool("danger") {
+		return logs
+	}
+	log.Println("Danger mode activated. Dump will include CRI container logs, which may contain sensitive information.")
+
+	limits = limits.withDefaults()
+	for _, c := range containers {
+		if c.LogPath == "" {
+			continue
+		}
+		path := c.LogPath
+		logs = append(logs, tarable.NewOutput(outputDir, "containers/"+c.Id+".log", "", func() []byte { return tail(path, limits) }))
+	}
+	return logs
+}
+
+// tail returns the last lines of a file, within the size limit
+func tail(path string, limits Logs) []byte {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Printf("error reading CRI container log: %s", err)
+		return []byte(fmt.Sprintf("error reading %s: %s\n", path, err))
+	}
+	defer f.Close()
+
+	max := int64(limits.MaxKB) * 1024
+	var start int64
+	if fi, err := f.Stat(); err == nil && fi.Size() > max {
+		start = fi.Size() - max
+	}
+	b := make([]byte, max)
+	n, err := f.ReadAt(b, start)
+	if err != nil && err != io.EOF {
+		return []byte(fmt.Sprintf("error reading %s: %s\n", path, err))
+	}
+	b = b[:n]
+	if start > 0 {
+		// the first line is cut
+		if i := bytes.IndexByte(b, '\n'); i >= 0 {
+			b = b[i+1:]
+		}
+	}
+
+	lines := bytes.SplitAfter(b, []byte("\n"))
+	if len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) > limits.Lines {
+		lines = lines[len(lines)-limits.Lines:]
+	}
+	return bytes.Join(lines, nil)
+}
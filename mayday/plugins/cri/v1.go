@@ -0,0 +1,80 @@
+package cri
+
+import (
+	"github.com/coreos/mayday/mayday/plugins/cri/v1alpha2"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// runtimeService is the part of the CRI runtime service mayday calls
+type runtimeService interface {
+	Version(ctx context.Context, in *v1alpha2.VersionRequest, opts ...grpc.CallOption) (*v1alpha2.VersionResponse, error)
+	ListPodSandbox(ctx context.Context, in *v1alpha2.ListPodSandboxRequest, opts ...grpc.CallOption) (*v1alpha2.ListPodSandboxResponse, error)
+	PodSandboxStatus(ctx context.Context, in *v1alpha2.PodSandboxStatusRequest, opts ...grpc.CallOption) (*v1alpha2.PodSandboxStatusResponse, error)
+	ListContainers(ctx context.Context, in *v1alpha2.ListContainersRequest, opts ...grpc.CallOption) (*v1alpha2.ListContainersResponse, error)
+	ContainerStatus(ctx context.Context, in *v1alpha2.ContainerStatusRequest, opts ...grpc.CallOption) (*v1alpha2.ContainerStatusResponse, error)
+}
+
+// imageService is the part of the CRI image service mayday calls
+type imageService interface {
+	ListImages(ctx context.Context, in *v1alpha2.ListImagesRequest, opts ...grpc.CallOption) (*v1alpha2.ListImagesResponse, error)
+}
+
+// v1Runtime calls the runtime service of the v1 CRI. Its messages are the
+// same as v1alpha2's on the wire, only its package differs.
+type v1Runtime struct {
+	cc *grpc.ClientConn
+}
+
+func (c v1Runtime) Version(ctx context.Context, in *v1alpha2.VersionRequest, opts ...grpc.CallOption) (*v1alpha2.VersionResponse, error) {
+	out := new(v1alpha2.VersionResponse)
+	if err := grpc.Invoke(ctx, "/runtime.v1.RuntimeService/Version", in, out, c.cc, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c v1Runtime) ListPodSandbox(ctx context.Context, in *v1alpha2.ListPodSandboxRequest, opts ...grpc.CallOption) (*v1alpha2.ListPodSandboxResponse, error) {
+	out := new(v1alpha2.ListPodSandboxResponse)
+	if err := grpc.Invoke(ctx, "/runtime.v1.RuntimeService/ListPodSandbox", in, out, c.cc, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c v1Runtime) PodSandboxStatus(ctx context.Context, in *v1alpha2.PodSandboxStatusRequest, opts ...grpc.CallOption) (*v1alpha2.PodSandboxStatusResponse, error) {
+	out := new(v1alpha2.PodSandboxStatusResponse)
+	if err := grpc.Invoke(ctx, "/runtime.v1.RuntimeService/PodSandboxStatus", in, out, c.cc, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c v1Runtime) ListContainers(ctx context.Context, in *v1alpha2.ListContainersRequest, opts ...grpc.CallOption) (*v1alpha2.ListContainersResponse, error) {
+	out := new(v1alpha2.ListContainersResponse)
+	if err := grpc.Invoke(ctx, "/runtime.v1.RuntimeService/ListContainers", in, out, c.cc, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c v1Runtime) ContainerStatus(ctx context.Context, in *v1alpha2.ContainerStatusRequest, opts ...grpc.CallOption) (*v1alpha2.ContainerStatusResponse, error) {
+	out := new(v1alpha2.ContainerStatusResponse)
+	if err := grpc.Invoke(ctx, "/runtime.v1.RuntimeService/ContainerStatus", in, out, c.cc, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// v1Image calls the image service of the v1 CRI
+type v1Image struct {
+	cc *grpc.ClientConn
+}
+
+func (c v1Image) ListImages(ctx context.Context, in *v1alpha2.ListImagesRequest, opts ...grpc.CallOption) (*v1alpha2.ListImagesResponse, error) {
+	out := new(v1alpha2.ListImagesResponse)
+	if err := grpc.Invoke(ctx, "/runtime.v1.ImageService/ListImages", in, out, c.cc, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,6 @@
+package v1alpha2
+
+// v1alpha2.go is generated from api.proto by protoc-gen-go at the version of
+// github.com/golang/protobuf in glide.lock, which matches the vendored proto
+// and grpc packages.
+//go:generate sh -c "protoc --go_out=plugins=grpc:. api.proto && mv api.pb.go v1alpha2.go"
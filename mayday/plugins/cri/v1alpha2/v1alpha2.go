@@ -0,0 +1,4093 @@
+// Code generated by protoc-gen-go.
+// source: api.proto
+// DO NOT EDIT!
+
+/*
+Package v1alpha2 is a generated protocol buffer package.
+
+It is generated from these files:
+
+	api.proto
+
+It has these top-level messages:
+
+	VersionRequest
+	VersionResponse
+	DNSConfig
+	PortMapping
+	Mount
+	NamespaceOption
+	Int64Value
+	LinuxSandboxSecurityContext
+	SecurityProfile
+	LinuxPodSandboxConfig
+	PodSandboxMetadata
+	PodSandboxConfig
+	RunPodSandboxRequest
+	RunPodSandboxResponse
+	StopPodSandboxRequest
+	StopPodSandboxResponse
+	RemovePodSandboxRequest
+	RemovePodSandboxResponse
+	PodSandboxStatusRequest
+	PodIP
+	PodSandboxNetworkStatus
+	Namespace
+	LinuxPodSandboxStatus
+	PodSandboxStatus
+	PodSandboxStatusResponse
+	PodSandboxStateValue
+	PodSandboxFilter
+	ListPodSandboxRequest
+	PodSandbox
+	ListPodSandboxResponse
+	ImageSpec
+	KeyValue
+	LinuxContainerResources
+	HugepageLimit
+	SELinuxOption
+	Capability
+	LinuxContainerSecurityContext
+	LinuxContainerConfig
+	WindowsContainerSecurityContext
+	WindowsContainerConfig
+	WindowsContainerResources
+	ContainerMetadata
+	Device
+	ContainerConfig
+	CreateContainerRequest
+	CreateContainerResponse
+	StartContainerRequest
+	StartContainerResponse
+	StopContainerRequest
+	StopContainerResponse
+	RemoveContainerRequest
+	RemoveContainerResponse
+	ContainerStateValue
+	ContainerFilter
+	ListContainersRequest
+	Container
+	ListContainersResponse
+	ContainerStatusRequest
+	ContainerStatus
+	ContainerStatusResponse
+	UpdateContainerResourcesRequest
+	UpdateContainerResourcesResponse
+	ExecSyncRequest
+	ExecSyncResponse
+	ExecRequest
+	ExecResponse
+	AttachRequest
+	AttachResponse
+	PortForwardRequest
+	PortForwardResponse
+	ImageFilter
+	ListImagesRequest
+	Image
+	ListImagesResponse
+	ImageStatusRequest
+	ImageStatusResponse
+	AuthConfig
+	PullImageRequest
+	PullImageResponse
+	RemoveImageRequest
+	RemoveImageResponse
+	NetworkConfig
+	RuntimeConfig
+	UpdateRuntimeConfigRequest
+	UpdateRuntimeConfigResponse
+	RuntimeCondition
+	RuntimeStatus
+	StatusRequest
+	StatusResponse
+	ImageFsInfoRequest
+	UInt64Value
+	FilesystemIdentifier
+	FilesystemUsage
+	ImageFsInfoResponse
+	ContainerStatsRequest
+	ContainerStatsResponse
+	ListContainerStatsRequest
+	ContainerStatsFilter
+	ListContainerStatsResponse
+	ContainerAttributes
+	ContainerStats
+	CpuUsage
+	MemoryUsage
+	ReopenContainerLogRequest
+	ReopenContainerLogResponse
+*/
+package v1alpha2
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+const _ = proto.ProtoPackageIsVersion1
+
+type Protocol int32
+
+const (
+	Protocol_TCP  Protocol = 0
+	Protocol_UDP  Protocol = 1
+	Protocol_SCTP Protocol = 2
+)
+
+var Protocol_name = map[int32]string{
+	0: "TCP",
+	1: "UDP",
+	2: "SCTP",
+}
+var Protocol_value = map[string]int32{
+	"TCP":  0,
+	"UDP":  1,
+	"SCTP": 2,
+}
+
+func (x Protocol) String() string {
+	return proto.EnumName(Protocol_name, int32(x))
+}
+func (Protocol) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type MountPropagation int32
+
+const (
+	// No mount propagation ("private" in Linux terminology).
+	MountPropagation_PROPAGATION_PRIVATE MountPropagation = 0
+	// Mounts get propagated from the host to the container ("rslave" in Linux).
+	MountPropagation_PROPAGATION_HOST_TO_CONTAINER MountPropagation = 1
+	// Mounts get propagated from the host to the container and from the
+	// container to the host ("rshared" in Linux).
+	MountPropagation_PROPAGATION_BIDIRECTIONAL MountPropagation = 2
+)
+
+var MountPropagation_name = map[int32]string{
+	0: "PROPAGATION_PRIVATE",
+	1: "PROPAGATION_HOST_TO_CONTAINER",
+	2: "PROPAGATION_BIDIRECTIONAL",
+}
+var MountPropagation_value = map[string]int32{
+	"PROPAGATION_PRIVATE":           0,
+	"PROPAGATION_HOST_TO_CONTAINER": 1,
+	"PROPAGATION_BIDIRECTIONAL":     2,
+}
+
+func (x MountPropagation) String() string {
+	return proto.EnumName(MountPropagation_name, int32(x))
+}
+func (MountPropagation) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+// A NamespaceMode describes the intended namespace configuration for each
+// of the namespaces (Network, PID, IPC) in NamespaceOption. Runtimes should
+// map these modes as appropriate for the technology underlying the runtime.
+type NamespaceMode int32
+
+const (
+	// A POD namespace is common to all containers in a pod.
+	// For example, a container with a PID namespace of POD expects to view
+	// all of the processes in all of the containers in the pod.
+	NamespaceMode_POD NamespaceMode = 0
+	// A CONTAINER namespace is restricted to a single container.
+	// For example, a container with a PID namespace of CONTAINER expects to
+	// view only the processes in that container.
+	NamespaceMode_CONTAINER NamespaceMode = 1
+	// A NODE namespace is the namespace of the Kubernetes node.
+	// For example, a container with a PID namespace of NODE expects to view
+	// all of the processes on the host running the kubelet.
+	NamespaceMode_NODE NamespaceMode = 2
+	// TARGET targets the namespace of another container. When this is specified,
+	// a target_id must be specified in NamespaceOption and refer to a container
+	// previously created with NamespaceMode CONTAINER. This containers namespace
+	// will be made to match that of container target_id.
+	// For example, a container with a PID namespace of TARGET expects to view
+	// all of the processes that container target_id can view.
+	NamespaceMode_TARGET NamespaceMode = 3
+)
+
+var NamespaceMode_name = map[int32]string{
+	0: "POD",
+	1: "CONTAINER",
+	2: "NODE",
+	3: "TARGET",
+}
+var NamespaceMode_value = map[string]int32{
+	"POD":       0,
+	"CONTAINER": 1,
+	"NODE":      2,
+	"TARGET":    3,
+}
+
+func (x NamespaceMode) String() string {
+	return proto.EnumName(NamespaceMode_name, int32(x))
+}
+func (NamespaceMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+type PodSandboxState int32
+
+const (
+	PodSandboxState_SANDBOX_READY    PodSandboxState = 0
+	PodSandboxState_SANDBOX_NOTREADY PodSandboxState = 1
+)
+
+var PodSandboxState_name = map[int32]string{
+	0: "SANDBOX_READY",
+	1: "SANDBOX_NOTREADY",
+}
+var PodSandboxState_value = map[string]int32{
+	"SANDBOX_READY":    0,
+	"SANDBOX_NOTREADY": 1,
+}
+
+func (x PodSandboxState) String() string {
+	return proto.EnumName(PodSandboxState_name, int32(x))
+}
+func (PodSandboxState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+type ContainerState int32
+
+const (
+	ContainerState_CONTAINER_CREATED ContainerState = 0
+	ContainerState_CONTAINER_RUNNING ContainerState = 1
+	ContainerState_CONTAINER_EXITED  ContainerState = 2
+	ContainerState_CONTAINER_UNKNOWN ContainerState = 3
+)
+
+var ContainerState_name = map[int32]string{
+	0: "CONTAINER_CREATED",
+	1: "CONTAINER_RUNNING",
+	2: "CONTAINER_EXITED",
+	3: "CONTAINER_UNKNOWN",
+}
+var ContainerState_value = map[string]int32{
+	"CONTAINER_CREATED": 0,
+	"CONTAINER_RUNNING": 1,
+	"CONTAINER_EXITED":  2,
+	"CONTAINER_UNKNOWN": 3,
+}
+
+func (x ContainerState) String() string {
+	return proto.EnumName(ContainerState_name, int32(x))
+}
+func (ContainerState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+// Available profile types.
+type SecurityProfile_ProfileType int32
+
+const (
+	// The container runtime default profile should be used.
+	SecurityProfile_RuntimeDefault SecurityProfile_ProfileType = 0
+	// Disable the feature for the sandbox or the container.
+	SecurityProfile_Unconfined SecurityProfile_ProfileType = 1
+	// A pre-defined profile on the node should be used.
+	SecurityProfile_Localhost SecurityProfile_ProfileType = 2
+)
+
+var SecurityProfile_ProfileType_name = map[int32]string{
+	0: "RuntimeDefault",
+	1: "Unconfined",
+	2: "Localhost",
+}
+var SecurityProfile_ProfileType_value = map[string]int32{
+	"RuntimeDefault": 0,
+	"Unconfined":     1,
+	"Localhost":      2,
+}
+
+func (x SecurityProfile_ProfileType) String() string {
+	return proto.EnumName(SecurityProfile_ProfileType_name, int32(x))
+}
+func (SecurityProfile_ProfileType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{8, 0}
+}
+
+type VersionRequest struct {
+	// Version of the kubelet runtime API.
+	Version string `protobuf:"bytes,1,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *VersionRequest) Reset()                    { *m = VersionRequest{} }
+func (m *VersionRequest) String() string            { return proto.CompactTextString(m) }
+func (*VersionRequest) ProtoMessage()               {}
+func (*VersionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type VersionResponse struct {
+	// Version of the kubelet runtime API.
+	Version string `protobuf:"bytes,1,opt,name=version" json:"version,omitempty"`
+	// Name of the container runtime.
+	RuntimeName string `protobuf:"bytes,2,opt,name=runtime_name" json:"runtime_name,omitempty"`
+	// Version of the container runtime. The string must be
+	// semver-compatible.
+	RuntimeVersion string `protobuf:"bytes,3,opt,name=runtime_version" json:"runtime_version,omitempty"`
+	// API version of the container runtime. The string must be
+	// semver-compatible.
+	RuntimeApiVersion string `protobuf:"bytes,4,opt,name=runtime_api_version" json:"runtime_api_version,omitempty"`
+}
+
+func (m *VersionResponse) Reset()                    { *m = VersionResponse{} }
+func (m *VersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*VersionResponse) ProtoMessage()               {}
+func (*VersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+// DNSConfig specifies the DNS servers and search domains of a sandbox.
+type DNSConfig struct {
+	// List of DNS servers of the cluster.
+	Servers []string `protobuf:"bytes,1,rep,name=servers" json:"servers,omitempty"`
+	// List of DNS search domains of the cluster.
+	Searches []string `protobuf:"bytes,2,rep,name=searches" json:"searches,omitempty"`
+	// List of DNS options. See https://linux.die.net/man/5/resolv.conf
+	// for all available options.
+	Options []string `protobuf:"bytes,3,rep,name=options" json:"options,omitempty"`
+}
+
+func (m *DNSConfig) Reset()                    { *m = DNSConfig{} }
+func (m *DNSConfig) String() string            { return proto.CompactTextString(m) }
+func (*DNSConfig) ProtoMessage()               {}
+func (*DNSConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+// PortMapping specifies the port mapping configurations of a sandbox.
+type PortMapping struct {
+	// Protocol of the port mapping.
+	Protocol Protocol `protobuf:"varint,1,opt,name=protocol,enum=runtime.v1alpha2.Protocol" json:"protocol,omitempty"`
+	// Port number within the container. Default: 0 (not specified).
+	ContainerPort int32 `protobuf:"varint,2,opt,name=container_port" json:"container_port,omitempty"`
+	// Port number on the host. Default: 0 (not specified).
+	HostPort int32 `protobuf:"varint,3,opt,name=host_port" json:"host_port,omitempty"`
+	// Host IP.
+	HostIp string `protobuf:"bytes,4,opt,name=host_ip" json:"host_ip,omitempty"`
+}
+
+func (m *PortMapping) Reset()                    { *m = PortMapping{} }
+func (m *PortMapping) String() string            { return proto.CompactTextString(m) }
+func (*PortMapping) ProtoMessage()               {}
+func (*PortMapping) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+// Mount specifies a host volume to mount into a container.
+type Mount struct {
+	// Path of the mount within the container.
+	ContainerPath string `protobuf:"bytes,1,opt,name=container_path" json:"container_path,omitempty"`
+	// Path of the mount on the host. If the hostPath doesn't exist, then runtimes
+	// should report error. If the hostpath is a symbolic link, runtimes should
+	// follow the symlink and mount the real destination to container.
+	HostPath string `protobuf:"bytes,2,opt,name=host_path" json:"host_path,omitempty"`
+	// If set, the mount is read-only.
+	Readonly bool `protobuf:"varint,3,opt,name=readonly" json:"readonly,omitempty"`
+	// If set, the mount needs SELinux relabeling.
+	SelinuxRelabel bool `protobuf:"varint,4,opt,name=selinux_relabel" json:"selinux_relabel,omitempty"`
+	// Requested propagation mode.
+	Propagation MountPropagation `protobuf:"varint,5,opt,name=propagation,enum=runtime.v1alpha2.MountPropagation" json:"propagation,omitempty"`
+}
+
+func (m *Mount) Reset()                    { *m = Mount{} }
+func (m *Mount) String() string            { return proto.CompactTextString(m) }
+func (*Mount) ProtoMessage()               {}
+func (*Mount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+// NamespaceOption provides options for Linux namespaces.
+type NamespaceOption struct {
+	// Network namespace for this container/sandbox.
+	// Note: There is currently no way to set CONTAINER scoped network in the Kubernetes API.
+	// Namespaces currently set by the kubelet: POD, NODE
+	Network NamespaceMode `protobuf:"varint,1,opt,name=network,enum=runtime.v1alpha2.NamespaceMode" json:"network,omitempty"`
+	// PID namespace for this container/sandbox.
+	// Note: The CRI default is POD, but the v1.PodSpec default is CONTAINER.
+	// The kubelet's runtime manager will set this to CONTAINER explicitly for v1 pods.
+	// Namespaces currently set by the kubelet: POD, CONTAINER, NODE, TARGET
+	Pid NamespaceMode `protobuf:"varint,2,opt,name=pid,enum=runtime.v1alpha2.NamespaceMode" json:"pid,omitempty"`
+	// IPC namespace for this container/sandbox.
+	// Note: There is currently no way to set CONTAINER scoped IPC in the Kubernetes API.
+	// Namespaces currently set by the kubelet: POD, NODE
+	Ipc NamespaceMode `protobuf:"varint,3,opt,name=ipc,enum=runtime.v1alpha2.NamespaceMode" json:"ipc,omitempty"`
+	// Target Container ID for NamespaceMode of TARGET. This container must have been
+	// previously created in the same pod. It is not possible to specify different targets
+	// for each namespace.
+	TargetId string `protobuf:"bytes,4,opt,name=target_id" json:"target_id,omitempty"`
+}
+
+func (m *NamespaceOption) Reset()                    { *m = NamespaceOption{} }
+func (m *NamespaceOption) String() string            { return proto.CompactTextString(m) }
+func (*NamespaceOption) ProtoMessage()               {}
+func (*NamespaceOption) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+// Int64Value is the wrapper of int64.
+type Int64Value struct {
+	// The value.
+	Value int64 `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *Int64Value) Reset()                    { *m = Int64Value{} }
+func (m *Int64Value) String() string            { return proto.CompactTextString(m) }
+func (*Int64Value) ProtoMessage()               {}
+func (*Int64Value) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+// LinuxSandboxSecurityContext holds linux security configuration that will be
+// applied to a sandbox. Note that:
+//  1. It does not apply to containers in the pods.
+//  2. It may not be applicable to a PodSandbox which does not contain any running
+//     process.
+type LinuxSandboxSecurityContext struct {
+	// Configurations for the sandbox's namespaces.
+	// This will be used only if the PodSandbox uses namespace for isolation.
+	NamespaceOptions *NamespaceOption `protobuf:"bytes,1,opt,name=namespace_options" json:"namespace_options,omitempty"`
+	// Optional SELinux context to be applied.
+	SelinuxOptions *SELinuxOption `protobuf:"bytes,2,opt,name=selinux_options" json:"selinux_options,omitempty"`
+	// UID to run sandbox processes as, when applicable.
+	RunAsUser *Int64Value `protobuf:"bytes,3,opt,name=run_as_user" json:"run_as_user,omitempty"`
+	// GID to run sandbox processes as, when applicable. run_as_group should only
+	// be specified when run_as_user is specified; otherwise, the runtime MUST error.
+	RunAsGroup *Int64Value `protobuf:"bytes,8,opt,name=run_as_group" json:"run_as_group,omitempty"`
+	// If set, the root filesystem of the sandbox is read-only.
+	ReadonlyRootfs bool `protobuf:"varint,4,opt,name=readonly_rootfs" json:"readonly_rootfs,omitempty"`
+	// List of groups applied to the first process run in the sandbox, in
+	// addition to the sandbox's primary GID.
+	SupplementalGroups []int64 `protobuf:"varint,5,rep,name=supplemental_groups" json:"supplemental_groups,omitempty"`
+	// Indicates whether the sandbox will be asked to run a privileged
+	// container. If a privileged container is to be executed within it, this
+	// MUST be true.
+	// This allows a sandbox to take additional security precautions if no
+	// privileged containers are expected to be run.
+	Privileged bool `protobuf:"varint,6,opt,name=privileged" json:"privileged,omitempty"`
+	// Seccomp profile for the sandbox.
+	Seccomp *SecurityProfile `protobuf:"bytes,9,opt,name=seccomp" json:"seccomp,omitempty"`
+	// AppArmor profile for the sandbox.
+	Apparmor *SecurityProfile `protobuf:"bytes,10,opt,name=apparmor" json:"apparmor,omitempty"`
+	// Seccomp profile for the sandbox, candidate values are:
+	// * runtime/default: the default profile for the container runtime
+	// * unconfined: unconfined profile, ie, no seccomp sandboxing
+	// * localhost/<full-path-to-profile>: the profile installed on the node.
+	//   <full-path-to-profile> is the full path of the profile.
+	// Default: "", which is identical with unconfined.
+	SeccompProfilePath string `protobuf:"bytes,7,opt,name=seccomp_profile_path" json:"seccomp_profile_path,omitempty"`
+}
+
+func (m *LinuxSandboxSecurityContext) Reset()                    { *m = LinuxSandboxSecurityContext{} }
+func (m *LinuxSandboxSecurityContext) String() string            { return proto.CompactTextString(m) }
+func (*LinuxSandboxSecurityContext) ProtoMessage()               {}
+func (*LinuxSandboxSecurityContext) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *LinuxSandboxSecurityContext) GetNamespaceOptions() *NamespaceOption {
+	if m != nil {
+		return m.NamespaceOptions
+	}
+	return nil
+}
+
+func (m *LinuxSandboxSecurityContext) GetSelinuxOptions() *SELinuxOption {
+	if m != nil {
+		return m.SelinuxOptions
+	}
+	return nil
+}
+
+func (m *LinuxSandboxSecurityContext) GetRunAsUser() *Int64Value {
+	if m != nil {
+		return m.RunAsUser
+	}
+	return nil
+}
+
+func (m *LinuxSandboxSecurityContext) GetRunAsGroup() *Int64Value {
+	if m != nil {
+		return m.RunAsGroup
+	}
+	return nil
+}
+
+func (m *LinuxSandboxSecurityContext) GetSeccomp() *SecurityProfile {
+	if m != nil {
+		return m.Seccomp
+	}
+	return nil
+}
+
+func (m *LinuxSandboxSecurityContext) GetApparmor() *SecurityProfile {
+	if m != nil {
+		return m.Apparmor
+	}
+	return nil
+}
+
+// A security profile which can be used for sandboxes and containers.
+type SecurityProfile struct {
+	// Indicator which `ProfileType` should be applied.
+	ProfileType SecurityProfile_ProfileType `protobuf:"varint,1,opt,name=profile_type,enum=runtime.v1alpha2.SecurityProfile_ProfileType" json:"profile_type,omitempty"`
+	// Indicates that a pre-defined profile on the node should be used.
+	// Must only be set if `ProfileType` is `Localhost`.
+	// For seccomp, it must be an absolute path to the seccomp profile.
+	// For AppArmor, this field is the AppArmor `<profile name>/`
+	LocalhostRef string `protobuf:"bytes,2,opt,name=localhost_ref" json:"localhost_ref,omitempty"`
+}
+
+func (m *SecurityProfile) Reset()                    { *m = SecurityProfile{} }
+func (m *SecurityProfile) String() string            { return proto.CompactTextString(m) }
+func (*SecurityProfile) ProtoMessage()               {}
+func (*SecurityProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+// LinuxPodSandboxConfig holds platform-specific configurations for Linux
+// host platforms and Linux-based containers.
+type LinuxPodSandboxConfig struct {
+	// Parent cgroup of the PodSandbox.
+	// The cgroupfs style syntax will be used, but the container runtime can
+	// convert it to systemd semantics if needed.
+	CgroupParent string `protobuf:"bytes,1,opt,name=cgroup_parent" json:"cgroup_parent,omitempty"`
+	// LinuxSandboxSecurityContext holds sandbox security attributes.
+	SecurityContext *LinuxSandboxSecurityContext `protobuf:"bytes,2,opt,name=security_context" json:"security_context,omitempty"`
+	// Sysctls holds linux sysctls config for the sandbox.
+	Sysctls map[string]string `protobuf:"bytes,3,rep,name=sysctls" json:"sysctls,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *LinuxPodSandboxConfig) Reset()                    { *m = LinuxPodSandboxConfig{} }
+func (m *LinuxPodSandboxConfig) String() string            { return proto.CompactTextString(m) }
+func (*LinuxPodSandboxConfig) ProtoMessage()               {}
+func (*LinuxPodSandboxConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *LinuxPodSandboxConfig) GetSecurityContext() *LinuxSandboxSecurityContext {
+	if m != nil {
+		return m.SecurityContext
+	}
+	return nil
+}
+
+func (m *LinuxPodSandboxConfig) GetSysctls() map[string]string {
+	if m != nil {
+		return m.Sysctls
+	}
+	return nil
+}
+
+// PodSandboxMetadata holds all necessary information for building the sandbox name.
+// The container runtime is encouraged to expose the metadata associated with the
+// PodSandbox in its user interface for better user experience. For example,
+// the runtime can construct a unique PodSandboxName based on the metadata.
+type PodSandboxMetadata struct {
+	// Pod name of the sandbox. Same as the pod name in the Pod ObjectMeta.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Pod UID of the sandbox. Same as the pod UID in the Pod ObjectMeta.
+	Uid string `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	// Pod namespace of the sandbox. Same as the pod namespace in the Pod ObjectMeta.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace" json:"namespace,omitempty"`
+	// Attempt number of creating the sandbox. Default: 0.
+	Attempt uint32 `protobuf:"varint,4,opt,name=attempt" json:"attempt,omitempty"`
+}
+
+func (m *PodSandboxMetadata) Reset()                    { *m = PodSandboxMetadata{} }
+func (m *PodSandboxMetadata) String() string            { return proto.CompactTextString(m) }
+func (*PodSandboxMetadata) ProtoMessage()               {}
+func (*PodSandboxMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+// PodSandboxConfig holds all the required and optional fields for creating a
+// sandbox.
+type PodSandboxConfig struct {
+	// Metadata of the sandbox. This information will uniquely identify the
+	// sandbox, and the runtime should leverage this to ensure correct
+	// operation. The runtime may also use this information to improve UX, such
+	// as by constructing a readable name.
+	Metadata *PodSandboxMetadata `protobuf:"bytes,1,opt,name=metadata" json:"metadata,omitempty"`
+	// Hostname of the sandbox. Hostname could only be empty when the pod
+	// network namespace is NODE.
+	Hostname string `protobuf:"bytes,2,opt,name=hostname" json:"hostname,omitempty"`
+	// Path to the directory on the host in which container log files are
+	// stored.
+	// By default the log of a container going into the LogDirectory will be
+	// hooked up to STDOUT and STDERR. However, the LogDirectory may contain
+	// binary log files with structured logging data from the individual
+	// containers. For example, the files might be newline separated JSON
+	// structured logs, systemd-journald journal files, gRPC trace files, etc.
+	// E.g.,
+	//     PodSandboxConfig.LogDirectory = `/var/log/pods/<podUID>/`
+	//     ContainerConfig.LogPath = `containerName/Instance#.log`
+	//
+	// WARNING: Log management and how kubelet should interface with the
+	// container logs are under active discussion in
+	// https://issues.k8s.io/24677. There *may* be future change of direction
+	// for logging as the discussion carries on.
+	LogDirectory string `protobuf:"bytes,3,opt,name=log_directory" json:"log_directory,omitempty"`
+	// DNS config for the sandbox.
+	DnsConfig *DNSConfig `protobuf:"bytes,4,opt,name=dns_config" json:"dns_config,omitempty"`
+	// Port mappings for the sandbox.
+	PortMappings []*PortMapping `protobuf:"bytes,5,rep,name=port_mappings" json:"port_mappings,omitempty"`
+	// Key-value pairs that may be used to scope and select individual resources.
+	Labels map[string]string `protobuf:"bytes,6,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Unstructured key-value map that may be set by the kubelet to store and
+	// retrieve arbitrary metadata. This will include any annotations set on a
+	// pod through the Kubernetes API.
+	//
+	// Annotations MUST NOT be altered by the runtime; the annotations stored
+	// here MUST be returned in the PodSandboxStatus associated with the pod
+	// this PodSandboxConfig creates.
+	//
+	// In general, in order to preserve a well-defined interface between the
+	// kubelet and the container runtime, annotations SHOULD NOT influence
+	// runtime behaviour.
+	//
+	// Annotations can also be useful for runtime authors to experiment with
+	// new features that are opaque to the Kubernetes APIs (both user-facing
+	// and the CRI). Whenever possible, however, runtime authors SHOULD
+	// consider proposing new typed fields for any new features instead.
+	Annotations map[string]string `protobuf:"bytes,7,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional configurations specific to Linux hosts.
+	Linux *LinuxPodSandboxConfig `protobuf:"bytes,8,opt,name=linux" json:"linux,omitempty"`
+}
+
+func (m *PodSandboxConfig) Reset()                    { *m = PodSandboxConfig{} }
+func (m *PodSandboxConfig) String() string            { return proto.CompactTextString(m) }
+func (*PodSandboxConfig) ProtoMessage()               {}
+func (*PodSandboxConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *PodSandboxConfig) GetMetadata() *PodSandboxMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PodSandboxConfig) GetDnsConfig() *DNSConfig {
+	if m != nil {
+		return m.DnsConfig
+	}
+	return nil
+}
+
+func (m *PodSandboxConfig) GetPortMappings() []*PortMapping {
+	if m != nil {
+		return m.PortMappings
+	}
+	return nil
+}
+
+func (m *PodSandboxConfig) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *PodSandboxConfig) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *PodSandboxConfig) GetLinux() *LinuxPodSandboxConfig {
+	if m != nil {
+		return m.Linux
+	}
+	return nil
+}
+
+type RunPodSandboxRequest struct {
+	// Configuration for creating a PodSandbox.
+	Config *PodSandboxConfig `protobuf:"bytes,1,opt,name=config" json:"config,omitempty"`
+	// Named runtime configuration to use for this PodSandbox.
+	// If the runtime handler is unknown, this request should be rejected.  An
+	// empty string should select the default handler, equivalent to the
+	// behavior before this feature was added.
+	// See https://git.k8s.io/enhancements/keps/sig-node/runtime-class.md
+	RuntimeHandler string `protobuf:"bytes,2,opt,name=runtime_handler" json:"runtime_handler,omitempty"`
+}
+
+func (m *RunPodSandboxRequest) Reset()                    { *m = RunPodSandboxRequest{} }
+func (m *RunPodSandboxRequest) String() string            { return proto.CompactTextString(m) }
+func (*RunPodSandboxRequest) ProtoMessage()               {}
+func (*RunPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *RunPodSandboxRequest) GetConfig() *PodSandboxConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type RunPodSandboxResponse struct {
+	// ID of the PodSandbox to run.
+	PodSandboxId string `protobuf:"bytes,1,opt,name=pod_sandbox_id" json:"pod_sandbox_id,omitempty"`
+}
+
+func (m *RunPodSandboxResponse) Reset()                    { *m = RunPodSandboxResponse{} }
+func (m *RunPodSandboxResponse) String() string            { return proto.CompactTextString(m) }
+func (*RunPodSandboxResponse) ProtoMessage()               {}
+func (*RunPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+type StopPodSandboxRequest struct {
+	// ID of the PodSandbox to stop.
+	PodSandboxId string `protobuf:"bytes,1,opt,name=pod_sandbox_id" json:"pod_sandbox_id,omitempty"`
+}
+
+func (m *StopPodSandboxRequest) Reset()                    { *m = StopPodSandboxRequest{} }
+func (m *StopPodSandboxRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopPodSandboxRequest) ProtoMessage()               {}
+func (*StopPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+type StopPodSandboxResponse struct {
+}
+
+func (m *StopPodSandboxResponse) Reset()                    { *m = StopPodSandboxResponse{} }
+func (m *StopPodSandboxResponse) String() string            { return proto.CompactTextString(m) }
+func (*StopPodSandboxResponse) ProtoMessage()               {}
+func (*StopPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+type RemovePodSandboxRequest struct {
+	// ID of the PodSandbox to remove.
+	PodSandboxId string `protobuf:"bytes,1,opt,name=pod_sandbox_id" json:"pod_sandbox_id,omitempty"`
+}
+
+func (m *RemovePodSandboxRequest) Reset()                    { *m = RemovePodSandboxRequest{} }
+func (m *RemovePodSandboxRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemovePodSandboxRequest) ProtoMessage()               {}
+func (*RemovePodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+type RemovePodSandboxResponse struct {
+}
+
+func (m *RemovePodSandboxResponse) Reset()                    { *m = RemovePodSandboxResponse{} }
+func (m *RemovePodSandboxResponse) String() string            { return proto.CompactTextString(m) }
+func (*RemovePodSandboxResponse) ProtoMessage()               {}
+func (*RemovePodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+type PodSandboxStatusRequest struct {
+	// ID of the PodSandbox for which to retrieve status.
+	PodSandboxId string `protobuf:"bytes,1,opt,name=pod_sandbox_id" json:"pod_sandbox_id,omitempty"`
+	// Verbose indicates whether to return extra information about the pod sandbox.
+	Verbose bool `protobuf:"varint,2,opt,name=verbose" json:"verbose,omitempty"`
+}
+
+func (m *PodSandboxStatusRequest) Reset()                    { *m = PodSandboxStatusRequest{} }
+func (m *PodSandboxStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodSandboxStatusRequest) ProtoMessage()               {}
+func (*PodSandboxStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+// PodIP represents an ip of a Pod
+type PodIP struct {
+	// an ip is a string representation of an IPv4 or an IPv6
+	Ip string `protobuf:"bytes,1,opt,name=ip" json:"ip,omitempty"`
+}
+
+func (m *PodIP) Reset()                    { *m = PodIP{} }
+func (m *PodIP) String() string            { return proto.CompactTextString(m) }
+func (*PodIP) ProtoMessage()               {}
+func (*PodIP) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+// PodSandboxNetworkStatus is the status of the network for a PodSandbox.
+type PodSandboxNetworkStatus struct {
+	// IP address of the PodSandbox.
+	Ip string `protobuf:"bytes,1,opt,name=ip" json:"ip,omitempty"`
+	// list of additional ips (not inclusive of PodSandboxNetworkStatus.Ip) of the PodSandBoxNetworkStatus
+	AdditionalIps []*PodIP `protobuf:"bytes,2,rep,name=additional_ips" json:"additional_ips,omitempty"`
+}
+
+func (m *PodSandboxNetworkStatus) Reset()                    { *m = PodSandboxNetworkStatus{} }
+func (m *PodSandboxNetworkStatus) String() string            { return proto.CompactTextString(m) }
+func (*PodSandboxNetworkStatus) ProtoMessage()               {}
+func (*PodSandboxNetworkStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *PodSandboxNetworkStatus) GetAdditionalIps() []*PodIP {
+	if m != nil {
+		return m.AdditionalIps
+	}
+	return nil
+}
+
+// Namespace contains paths to the namespaces.
+type Namespace struct {
+	// Namespace options for Linux namespaces.
+	Options *NamespaceOption `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
+}
+
+func (m *Namespace) Reset()                    { *m = Namespace{} }
+func (m *Namespace) String() string            { return proto.CompactTextString(m) }
+func (*Namespace) ProtoMessage()               {}
+func (*Namespace) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *Namespace) GetOptions() *NamespaceOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// LinuxSandboxStatus contains status specific to Linux sandboxes.
+type LinuxPodSandboxStatus struct {
+	// Paths to the sandbox's namespaces.
+	Namespaces *Namespace `protobuf:"bytes,1,opt,name=namespaces" json:"namespaces,omitempty"`
+}
+
+func (m *LinuxPodSandboxStatus) Reset()                    { *m = LinuxPodSandboxStatus{} }
+func (m *LinuxPodSandboxStatus) String() string            { return proto.CompactTextString(m) }
+func (*LinuxPodSandboxStatus) ProtoMessage()               {}
+func (*LinuxPodSandboxStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *LinuxPodSandboxStatus) GetNamespaces() *Namespace {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+// PodSandboxStatus contains the status of the PodSandbox.
+type PodSandboxStatus struct {
+	// ID of the sandbox.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Metadata of the sandbox.
+	Metadata *PodSandboxMetadata `protobuf:"bytes,2,opt,name=metadata" json:"metadata,omitempty"`
+	// State of the sandbox.
+	State PodSandboxState `protobuf:"varint,3,opt,name=state,enum=runtime.v1alpha2.PodSandboxState" json:"state,omitempty"`
+	// Creation timestamp of the sandbox in nanoseconds. Must be > 0.
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at" json:"created_at,omitempty"`
+	// Network contains network status if network is handled by the runtime.
+	Network *PodSandboxNetworkStatus `protobuf:"bytes,5,opt,name=network" json:"network,omitempty"`
+	// Linux-specific status to a pod sandbox.
+	Linux *LinuxPodSandboxStatus `protobuf:"bytes,6,opt,name=linux" json:"linux,omitempty"`
+	// Labels are key-value pairs that may be used to scope and select individual resources.
+	Labels map[string]string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Unstructured key-value map holding arbitrary metadata.
+	// Annotations MUST NOT be altered by the runtime; the value of this field
+	// MUST be identical to that of the corresponding PodSandboxConfig used to
+	// instantiate the pod sandbox this status represents.
+	Annotations map[string]string `protobuf:"bytes,8,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// runtime configuration used for this PodSandbox.
+	RuntimeHandler string `protobuf:"bytes,9,opt,name=runtime_handler" json:"runtime_handler,omitempty"`
+}
+
+func (m *PodSandboxStatus) Reset()                    { *m = PodSandboxStatus{} }
+func (m *PodSandboxStatus) String() string            { return proto.CompactTextString(m) }
+func (*PodSandboxStatus) ProtoMessage()               {}
+func (*PodSandboxStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *PodSandboxStatus) GetMetadata() *PodSandboxMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PodSandboxStatus) GetNetwork() *PodSandboxNetworkStatus {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+func (m *PodSandboxStatus) GetLinux() *LinuxPodSandboxStatus {
+	if m != nil {
+		return m.Linux
+	}
+	return nil
+}
+
+func (m *PodSandboxStatus) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *PodSandboxStatus) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+type PodSandboxStatusResponse struct {
+	// Status of the PodSandbox.
+	Status *PodSandboxStatus `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+	// Info is extra information of the PodSandbox. The key could be arbitrary string, and
+	// value should be in json format. The information could include anything useful for
+	// debug, e.g. network namespace for linux container based container runtime.
+	// It should only be returned non-empty when Verbose is true.
+	Info map[string]string `protobuf:"bytes,2,rep,name=info" json:"info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *PodSandboxStatusResponse) Reset()                    { *m = PodSandboxStatusResponse{} }
+func (m *PodSandboxStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodSandboxStatusResponse) ProtoMessage()               {}
+func (*PodSandboxStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *PodSandboxStatusResponse) GetStatus() *PodSandboxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *PodSandboxStatusResponse) GetInfo() map[string]string {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+// PodSandboxStateValue is the wrapper of PodSandboxState.
+type PodSandboxStateValue struct {
+	// State of the sandbox.
+	State PodSandboxState `protobuf:"varint,1,opt,name=state,enum=runtime.v1alpha2.PodSandboxState" json:"state,omitempty"`
+}
+
+func (m *PodSandboxStateValue) Reset()                    { *m = PodSandboxStateValue{} }
+func (m *PodSandboxStateValue) String() string            { return proto.CompactTextString(m) }
+func (*PodSandboxStateValue) ProtoMessage()               {}
+func (*PodSandboxStateValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+// PodSandboxFilter is used to filter a list of PodSandboxes.
+// All those fields are combined with 'AND'
+type PodSandboxFilter struct {
+	// ID of the sandbox.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// State of the sandbox.
+	State *PodSandboxStateValue `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
+	// LabelSelector to select matches.
+	// Only api.MatchLabels is supported for now and the requirements
+	// are ANDed. MatchExpressions is not supported yet.
+	LabelSelector map[string]string `protobuf:"bytes,3,rep,name=label_selector" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *PodSandboxFilter) Reset()                    { *m = PodSandboxFilter{} }
+func (m *PodSandboxFilter) String() string            { return proto.CompactTextString(m) }
+func (*PodSandboxFilter) ProtoMessage()               {}
+func (*PodSandboxFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *PodSandboxFilter) GetState() *PodSandboxStateValue {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *PodSandboxFilter) GetLabelSelector() map[string]string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
+}
+
+type ListPodSandboxRequest struct {
+	// PodSandboxFilter to filter a list of PodSandboxes.
+	Filter *PodSandboxFilter `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+}
+
+func (m *ListPodSandboxRequest) Reset()                    { *m = ListPodSandboxRequest{} }
+func (m *ListPodSandboxRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPodSandboxRequest) ProtoMessage()               {}
+func (*ListPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *ListPodSandboxRequest) GetFilter() *PodSandboxFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+// PodSandbox contains minimal information about a sandbox.
+type PodSandbox struct {
+	// ID of the PodSandbox.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Metadata of the PodSandbox.
+	Metadata *PodSandboxMetadata `protobuf:"bytes,2,opt,name=metadata" json:"metadata,omitempty"`
+	// State of the PodSandbox.
+	State PodSandboxState `protobuf:"varint,3,opt,name=state,enum=runtime.v1alpha2.PodSandboxState" json:"state,omitempty"`
+	// Creation timestamps of the PodSandbox in nanoseconds. Must be > 0.
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at" json:"created_at,omitempty"`
+	// Labels of the PodSandbox.
+	Labels map[string]string `protobuf:"bytes,5,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Unstructured key-value map holding arbitrary metadata.
+	// Annotations MUST NOT be altered by the runtime; the value of this field
+	// MUST be identical to that of the corresponding PodSandboxConfig used to
+	// instantiate this PodSandbox.
+	Annotations map[string]string `protobuf:"bytes,6,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// runtime configuration used for this PodSandbox.
+	RuntimeHandler string `protobuf:"bytes,7,opt,name=runtime_handler" json:"runtime_handler,omitempty"`
+}
+
+func (m *PodSandbox) Reset()                    { *m = PodSandbox{} }
+func (m *PodSandbox) String() string            { return proto.CompactTextString(m) }
+func (*PodSandbox) ProtoMessage()               {}
+func (*PodSandbox) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *PodSandbox) GetMetadata() *PodSandboxMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PodSandbox) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *PodSandbox) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+type ListPodSandboxResponse struct {
+	// List of PodSandboxes.
+	Items []*PodSandbox `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+}
+
+func (m *ListPodSandboxResponse) Reset()                    { *m = ListPodSandboxResponse{} }
+func (m *ListPodSandboxResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListPodSandboxResponse) ProtoMessage()               {}
+func (*ListPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *ListPodSandboxResponse) GetItems() []*PodSandbox {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// ImageSpec is an internal representation of an image.
+type ImageSpec struct {
+	// Container's Image field (e.g. imageID or imageDigest).
+	Image string `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+	// Unstructured key-value map holding arbitrary metadata.
+	// ImageSpec Annotations can be used to help the runtime target specific
+	// images in multi-arch images.
+	Annotations map[string]string `protobuf:"bytes,2,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ImageSpec) Reset()                    { *m = ImageSpec{} }
+func (m *ImageSpec) String() string            { return proto.CompactTextString(m) }
+func (*ImageSpec) ProtoMessage()               {}
+func (*ImageSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *ImageSpec) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+type KeyValue struct {
+	Key   string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *KeyValue) Reset()                    { *m = KeyValue{} }
+func (m *KeyValue) String() string            { return proto.CompactTextString(m) }
+func (*KeyValue) ProtoMessage()               {}
+func (*KeyValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+// LinuxContainerResources specifies Linux specific configuration for
+// resources.
+// TODO: Consider using Resources from opencontainers/runtime-spec/specs-go
+// directly.
+type LinuxContainerResources struct {
+	// CPU CFS (Completely Fair Scheduler) period. Default: 0 (not specified).
+	CpuPeriod int64 `protobuf:"varint,1,opt,name=cpu_period" json:"cpu_period,omitempty"`
+	// CPU CFS (Completely Fair Scheduler) quota. Default: 0 (not specified).
+	CpuQuota int64 `protobuf:"varint,2,opt,name=cpu_quota" json:"cpu_quota,omitempty"`
+	// CPU shares (relative weight vs. other containers). Default: 0 (not specified).
+	CpuShares int64 `protobuf:"varint,3,opt,name=cpu_shares" json:"cpu_shares,omitempty"`
+	// Memory limit in bytes. Default: 0 (not specified).
+	MemoryLimitInBytes int64 `protobuf:"varint,4,opt,name=memory_limit_in_bytes" json:"memory_limit_in_bytes,omitempty"`
+	// OOMScoreAdj adjusts the oom-killer score. Default: 0 (not specified).
+	OomScoreAdj int64 `protobuf:"varint,5,opt,name=oom_score_adj" json:"oom_score_adj,omitempty"`
+	// CpusetCpus constrains the allowed set of logical CPUs. Default: "" (not specified).
+	CpusetCpus string `protobuf:"bytes,6,opt,name=cpuset_cpus" json:"cpuset_cpus,omitempty"`
+	// CpusetMems constrains the allowed set of memory nodes. Default: "" (not specified).
+	CpusetMems string `protobuf:"bytes,7,opt,name=cpuset_mems" json:"cpuset_mems,omitempty"`
+	// List of HugepageLimits to limit the HugeTLB usage of container per page size. Default: nil (not specified).
+	HugepageLimits []*HugepageLimit `protobuf:"bytes,8,rep,name=hugepage_limits" json:"hugepage_limits,omitempty"`
+}
+
+func (m *LinuxContainerResources) Reset()                    { *m = LinuxContainerResources{} }
+func (m *LinuxContainerResources) String() string            { return proto.CompactTextString(m) }
+func (*LinuxContainerResources) ProtoMessage()               {}
+func (*LinuxContainerResources) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *LinuxContainerResources) GetHugepageLimits() []*HugepageLimit {
+	if m != nil {
+		return m.HugepageLimits
+	}
+	return nil
+}
+
+// HugepageLimit corresponds to the file`hugetlb.<hugepagesize>.limit_in_byte` in container level cgroup.
+// For example, `PageSize=1GB`, `Limit=1073741824` means setting `1073741824` bytes to hugetlb.1GB.limit_in_bytes.
+type HugepageLimit struct {
+	// The value of PageSize has the format <size><unit-prefix>B (2MB, 1GB),
+	// and must match the <hugepagesize> of the corresponding control file found in `hugetlb.<hugepagesize>.limit_in_bytes`.
+	// The values of <unit-prefix> are intended to be parsed using base 1024("1KB" = 1024, "1MB" = 1048576, etc).
+	PageSize string `protobuf:"bytes,1,opt,name=page_size" json:"page_size,omitempty"`
+	// limit in bytes of hugepagesize HugeTLB usage.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *HugepageLimit) Reset()                    { *m = HugepageLimit{} }
+func (m *HugepageLimit) String() string            { return proto.CompactTextString(m) }
+func (*HugepageLimit) ProtoMessage()               {}
+func (*HugepageLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+// SELinuxOption are the labels to be applied to the container.
+type SELinuxOption struct {
+	User  string `protobuf:"bytes,1,opt,name=user" json:"user,omitempty"`
+	Role  string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+	Type  string `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	Level string `protobuf:"bytes,4,opt,name=level" json:"level,omitempty"`
+}
+
+func (m *SELinuxOption) Reset()                    { *m = SELinuxOption{} }
+func (m *SELinuxOption) String() string            { return proto.CompactTextString(m) }
+func (*SELinuxOption) ProtoMessage()               {}
+func (*SELinuxOption) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+// Capability contains the container capabilities to add or drop
+type Capability struct {
+	// List of capabilities to add.
+	AddCapabilities []string `protobuf:"bytes,1,rep,name=add_capabilities" json:"add_capabilities,omitempty"`
+	// List of capabilities to drop.
+	DropCapabilities []string `protobuf:"bytes,2,rep,name=drop_capabilities" json:"drop_capabilities,omitempty"`
+}
+
+func (m *Capability) Reset()                    { *m = Capability{} }
+func (m *Capability) String() string            { return proto.CompactTextString(m) }
+func (*Capability) ProtoMessage()               {}
+func (*Capability) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+// LinuxContainerSecurityContext holds linux security configuration that will be applied to a container.
+type LinuxContainerSecurityContext struct {
+	// Capabilities to add or drop.
+	Capabilities *Capability `protobuf:"bytes,1,opt,name=capabilities" json:"capabilities,omitempty"`
+	// If set, run container in privileged mode.
+	// Privileged mode is incompatible with the following options. If
+	// privileged is set, the following features MAY have no effect:
+	// 1. capabilities
+	// 2. selinux_options
+	// 4. seccomp
+	// 5. apparmor
+	//
+	// Privileged mode implies the following specific options are applied:
+	// 1. All capabilities are added.
+	// 2. Sensitive paths, such as kernel module paths within sysfs, are not masked.
+	// 3. Any sysfs and procfs mounts are mounted RW.
+	// 4. AppArmor confinement is not applied.
+	// 5. Seccomp restrictions are not applied.
+	// 6. The device cgroup does not restrict access to any devices.
+	// 7. All devices from the host's /dev are available within the container.
+	// 8. SELinux restrictions are not applied (e.g. label=disabled).
+	Privileged bool `protobuf:"varint,2,opt,name=privileged" json:"privileged,omitempty"`
+	// Configurations for the container's namespaces.
+	// Only used if the container uses namespace for isolation.
+	NamespaceOptions *NamespaceOption `protobuf:"bytes,3,opt,name=namespace_options" json:"namespace_options,omitempty"`
+	// SELinux context to be optionally applied.
+	SelinuxOptions *SELinuxOption `protobuf:"bytes,4,opt,name=selinux_options" json:"selinux_options,omitempty"`
+	// UID to run the container process as. Only one of run_as_user and
+	// run_as_username can be specified at a time.
+	RunAsUser *Int64Value `protobuf:"bytes,5,opt,name=run_as_user" json:"run_as_user,omitempty"`
+	// GID to run the container process as. run_as_group should only be specified
+	// when run_as_user or run_as_username is specified; otherwise, the runtime
+	// MUST error.
+	RunAsGroup *Int64Value `protobuf:"bytes,12,opt,name=run_as_group" json:"run_as_group,omitempty"`
+	// User name to run the container process as. If specified, the user MUST
+	// exist in the container image (i.e. in the /etc/passwd inside the image),
+	// and be resolved there by the runtime; otherwise, the runtime MUST error.
+	RunAsUsername string `protobuf:"bytes,6,opt,name=run_as_username" json:"run_as_username,omitempty"`
+	// If set, the root filesystem of the container is read-only.
+	ReadonlyRootfs bool `protobuf:"varint,7,opt,name=readonly_rootfs" json:"readonly_rootfs,omitempty"`
+	// List of groups applied to the first process run in the container, in
+	// addition to the container's primary GID.
+	SupplementalGroups []int64 `protobuf:"varint,8,rep,name=supplemental_groups" json:"supplemental_groups,omitempty"`
+	// no_new_privs defines if the flag for no_new_privs should be set on the
+	// container.
+	NoNewPrivs bool `protobuf:"varint,11,opt,name=no_new_privs" json:"no_new_privs,omitempty"`
+	// masked_paths is a slice of paths that should be masked by the container
+	// runtime, this can be passed directly to the OCI spec.
+	MaskedPaths []string `protobuf:"bytes,13,rep,name=masked_paths" json:"masked_paths,omitempty"`
+	// readonly_paths is a slice of paths that should be set as readonly by the
+	// container runtime, this can be passed directly to the OCI spec.
+	ReadonlyPaths []string `protobuf:"bytes,14,rep,name=readonly_paths" json:"readonly_paths,omitempty"`
+	// Seccomp profile for the container.
+	Seccomp *SecurityProfile `protobuf:"bytes,15,opt,name=seccomp" json:"seccomp,omitempty"`
+	// AppArmor profile for the container.
+	Apparmor *SecurityProfile `protobuf:"bytes,16,opt,name=apparmor" json:"apparmor,omitempty"`
+	// AppArmor profile for the container, candidate values are:
+	// * runtime/default: equivalent to not specifying a profile.
+	// * unconfined: no profiles are loaded
+	// * localhost/<profile_name>: profile loaded on the node
+	//    (localhost) by name. The possible profile names are detailed at
+	//    https://gitlab.com/apparmor/apparmor/-/wikis/AppArmor_Core_Policy_Reference
+	ApparmorProfile string `protobuf:"bytes,9,opt,name=apparmor_profile" json:"apparmor_profile,omitempty"`
+	// Seccomp profile for the container, candidate values are:
+	// * runtime/default: the default profile for the container runtime
+	// * unconfined: unconfined profile, ie, no seccomp sandboxing
+	// * localhost/<full-path-to-profile>: the profile installed on the node.
+	//   <full-path-to-profile> is the full path of the profile.
+	// Default: "", which is identical with unconfined.
+	SeccompProfilePath string `protobuf:"bytes,10,opt,name=seccomp_profile_path" json:"seccomp_profile_path,omitempty"`
+}
+
+func (m *LinuxContainerSecurityContext) Reset()                    { *m = LinuxContainerSecurityContext{} }
+func (m *LinuxContainerSecurityContext) String() string            { return proto.CompactTextString(m) }
+func (*LinuxContainerSecurityContext) ProtoMessage()               {}
+func (*LinuxContainerSecurityContext) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *LinuxContainerSecurityContext) GetCapabilities() *Capability {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *LinuxContainerSecurityContext) GetNamespaceOptions() *NamespaceOption {
+	if m != nil {
+		return m.NamespaceOptions
+	}
+	return nil
+}
+
+func (m *LinuxContainerSecurityContext) GetSelinuxOptions() *SELinuxOption {
+	if m != nil {
+		return m.SelinuxOptions
+	}
+	return nil
+}
+
+func (m *LinuxContainerSecurityContext) GetRunAsUser() *Int64Value {
+	if m != nil {
+		return m.RunAsUser
+	}
+	return nil
+}
+
+func (m *LinuxContainerSecurityContext) GetRunAsGroup() *Int64Value {
+	if m != nil {
+		return m.RunAsGroup
+	}
+	return nil
+}
+
+func (m *LinuxContainerSecurityContext) GetSeccomp() *SecurityProfile {
+	if m != nil {
+		return m.Seccomp
+	}
+	return nil
+}
+
+func (m *LinuxContainerSecurityContext) GetApparmor() *SecurityProfile {
+	if m != nil {
+		return m.Apparmor
+	}
+	return nil
+}
+
+// LinuxContainerConfig contains platform-specific configuration for
+// Linux-based containers.
+type LinuxContainerConfig struct {
+	// Resources specification for the container.
+	Resources *LinuxContainerResources `protobuf:"bytes,1,opt,name=resources" json:"resources,omitempty"`
+	// LinuxContainerSecurityContext configuration for the container.
+	SecurityContext *LinuxContainerSecurityContext `protobuf:"bytes,2,opt,name=security_context" json:"security_context,omitempty"`
+}
+
+func (m *LinuxContainerConfig) Reset()                    { *m = LinuxContainerConfig{} }
+func (m *LinuxContainerConfig) String() string            { return proto.CompactTextString(m) }
+func (*LinuxContainerConfig) ProtoMessage()               {}
+func (*LinuxContainerConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *LinuxContainerConfig) GetResources() *LinuxContainerResources {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *LinuxContainerConfig) GetSecurityContext() *LinuxContainerSecurityContext {
+	if m != nil {
+		return m.SecurityContext
+	}
+	return nil
+}
+
+// WindowsContainerSecurityContext holds windows security configuration that will be applied to a container.
+type WindowsContainerSecurityContext struct {
+	// User name to run the container process as. If specified, the user MUST
+	// exist in the container image and be resolved there by the runtime;
+	// otherwise, the runtime MUST return error.
+	RunAsUsername string `protobuf:"bytes,1,opt,name=run_as_username" json:"run_as_username,omitempty"`
+	// The contents of the GMSA credential spec to use to run this container.
+	CredentialSpec string `protobuf:"bytes,2,opt,name=credential_spec" json:"credential_spec,omitempty"`
+}
+
+func (m *WindowsContainerSecurityContext) Reset()         { *m = WindowsContainerSecurityContext{} }
+func (m *WindowsContainerSecurityContext) String() string { return proto.CompactTextString(m) }
+func (*WindowsContainerSecurityContext) ProtoMessage()    {}
+func (*WindowsContainerSecurityContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{38}
+}
+
+// WindowsContainerConfig contains platform-specific configuration for
+// Windows-based containers.
+type WindowsContainerConfig struct {
+	// Resources specification for the container.
+	Resources *WindowsContainerResources `protobuf:"bytes,1,opt,name=resources" json:"resources,omitempty"`
+	// WindowsContainerSecurityContext configuration for the container.
+	SecurityContext *WindowsContainerSecurityContext `protobuf:"bytes,2,opt,name=security_context" json:"security_context,omitempty"`
+}
+
+func (m *WindowsContainerConfig) Reset()                    { *m = WindowsContainerConfig{} }
+func (m *WindowsContainerConfig) String() string            { return proto.CompactTextString(m) }
+func (*WindowsContainerConfig) ProtoMessage()               {}
+func (*WindowsContainerConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *WindowsContainerConfig) GetResources() *WindowsContainerResources {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *WindowsContainerConfig) GetSecurityContext() *WindowsContainerSecurityContext {
+	if m != nil {
+		return m.SecurityContext
+	}
+	return nil
+}
+
+// WindowsContainerResources specifies Windows specific configuration for
+// resources.
+type WindowsContainerResources struct {
+	// CPU shares (relative weight vs. other containers). Default: 0 (not specified).
+	CpuShares int64 `protobuf:"varint,1,opt,name=cpu_shares" json:"cpu_shares,omitempty"`
+	// Number of CPUs available to the container. Default: 0 (not specified).
+	CpuCount int64 `protobuf:"varint,2,opt,name=cpu_count" json:"cpu_count,omitempty"`
+	// Specifies the portion of processor cycles that this container can use as a percentage times 100.
+	CpuMaximum int64 `protobuf:"varint,3,opt,name=cpu_maximum" json:"cpu_maximum,omitempty"`
+	// Memory limit in bytes. Default: 0 (not specified).
+	MemoryLimitInBytes int64 `protobuf:"varint,4,opt,name=memory_limit_in_bytes" json:"memory_limit_in_bytes,omitempty"`
+}
+
+func (m *WindowsContainerResources) Reset()                    { *m = WindowsContainerResources{} }
+func (m *WindowsContainerResources) String() string            { return proto.CompactTextString(m) }
+func (*WindowsContainerResources) ProtoMessage()               {}
+func (*WindowsContainerResources) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+// ContainerMetadata holds all necessary information for building the container
+// name. The container runtime is encouraged to expose the metadata in its user
+// interface for better user experience. E.g., runtime can construct a unique
+// container name based on the metadata. Note that (name, attempt) is unique
+// within a sandbox for the entire lifetime of the sandbox.
+type ContainerMetadata struct {
+	// Name of the container. Same as the container name in the PodSpec.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Attempt number of creating the container. Default: 0.
+	Attempt uint32 `protobuf:"varint,2,opt,name=attempt" json:"attempt,omitempty"`
+}
+
+func (m *ContainerMetadata) Reset()                    { *m = ContainerMetadata{} }
+func (m *ContainerMetadata) String() string            { return proto.CompactTextString(m) }
+func (*ContainerMetadata) ProtoMessage()               {}
+func (*ContainerMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+// Device specifies a host device to mount into a container.
+type Device struct {
+	// Path of the device within the container.
+	ContainerPath string `protobuf:"bytes,1,opt,name=container_path" json:"container_path,omitempty"`
+	// Path of the device on the host.
+	HostPath string `protobuf:"bytes,2,opt,name=host_path" json:"host_path,omitempty"`
+	// Cgroups permissions of the device, candidates are one or more of
+	// * r - allows container to read from the specified device.
+	// * w - allows container to write to the specified device.
+	// * m - allows container to create device files that do not yet exist.
+	Permissions string `protobuf:"bytes,3,opt,name=permissions" json:"permissions,omitempty"`
+}
+
+func (m *Device) Reset()                    { *m = Device{} }
+func (m *Device) String() string            { return proto.CompactTextString(m) }
+func (*Device) ProtoMessage()               {}
+func (*Device) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+// ContainerConfig holds all the required and optional fields for creating a
+// container.
+type ContainerConfig struct {
+	// Metadata of the container. This information will uniquely identify the
+	// container, and the runtime should leverage this to ensure correct
+	// operation. The runtime may also use this information to improve UX, such
+	// as by constructing a readable name.
+	Metadata *ContainerMetadata `protobuf:"bytes,1,opt,name=metadata" json:"metadata,omitempty"`
+	// Image to use.
+	Image *ImageSpec `protobuf:"bytes,2,opt,name=image" json:"image,omitempty"`
+	// Command to execute (i.e., entrypoint for docker)
+	Command []string `protobuf:"bytes,3,rep,name=command" json:"command,omitempty"`
+	// Args for the Command (i.e., command for docker)
+	Args []string `protobuf:"bytes,4,rep,name=args" json:"args,omitempty"`
+	// Current working directory of the command.
+	WorkingDir string `protobuf:"bytes,5,opt,name=working_dir" json:"working_dir,omitempty"`
+	// List of environment variable to set in the container.
+	Envs []*KeyValue `protobuf:"bytes,6,rep,name=envs" json:"envs,omitempty"`
+	// Mounts for the container.
+	Mounts []*Mount `protobuf:"bytes,7,rep,name=mounts" json:"mounts,omitempty"`
+	// Devices for the container.
+	Devices []*Device `protobuf:"bytes,8,rep,name=devices" json:"devices,omitempty"`
+	// Key-value pairs that may be used to scope and select individual resources.
+	// Label keys are of the form:
+	//     label-key ::= prefixed-name | name
+	//     prefixed-name ::= prefix '/' name
+	//     prefix ::= DNS_SUBDOMAIN
+	//     name ::= DNS_LABEL
+	Labels map[string]string `protobuf:"bytes,9,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Unstructured key-value map that may be used by the kubelet to store and
+	// retrieve arbitrary metadata.
+	//
+	// Annotations MUST NOT be altered by the runtime; the annotations stored
+	// here MUST be returned in the ContainerStatus associated with the container
+	// this ContainerConfig creates.
+	//
+	// In general, in order to preserve a well-defined interface between the
+	// kubelet and the container runtime, annotations SHOULD NOT influence
+	// runtime behaviour.
+	Annotations map[string]string `protobuf:"bytes,10,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Path relative to PodSandboxConfig.LogDirectory for container to store
+	// the log (STDOUT and STDERR) on the host.
+	// E.g.,
+	//     PodSandboxConfig.LogDirectory = `/var/log/pods/<podUID>/`
+	//     ContainerConfig.LogPath = `containerName/Instance#.log`
+	//
+	// WARNING: Log management and how kubelet should interface with the
+	// container logs are under active discussion in
+	// https://issues.k8s.io/24677. There *may* be future change of direction
+	// for logging as the discussion carries on.
+	LogPath string `protobuf:"bytes,11,opt,name=log_path" json:"log_path,omitempty"`
+	// Variables for interactive containers, these have very specialized
+	// use-cases (e.g. debugging).
+	// TODO: Determine if we need to continue supporting these fields that are
+	// part of Kubernetes's Container Spec.
+	Stdin     bool `protobuf:"varint,12,opt,name=stdin" json:"stdin,omitempty"`
+	StdinOnce bool `protobuf:"varint,13,opt,name=stdin_once" json:"stdin_once,omitempty"`
+	Tty       bool `protobuf:"varint,14,opt,name=tty" json:"tty,omitempty"`
+	// Configuration specific to Linux containers.
+	Linux *LinuxContainerConfig `protobuf:"bytes,15,opt,name=linux" json:"linux,omitempty"`
+	// Configuration specific to Windows containers.
+	Windows *WindowsContainerConfig `protobuf:"bytes,16,opt,name=windows" json:"windows,omitempty"`
+}
+
+func (m *ContainerConfig) Reset()                    { *m = ContainerConfig{} }
+func (m *ContainerConfig) String() string            { return proto.CompactTextString(m) }
+func (*ContainerConfig) ProtoMessage()               {}
+func (*ContainerConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *ContainerConfig) GetMetadata() *ContainerMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ContainerConfig) GetImage() *ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *ContainerConfig) GetEnvs() []*KeyValue {
+	if m != nil {
+		return m.Envs
+	}
+	return nil
+}
+
+func (m *ContainerConfig) GetMounts() []*Mount {
+	if m != nil {
+		return m.Mounts
+	}
+	return nil
+}
+
+func (m *ContainerConfig) GetDevices() []*Device {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *ContainerConfig) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ContainerConfig) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *ContainerConfig) GetLinux() *LinuxContainerConfig {
+	if m != nil {
+		return m.Linux
+	}
+	return nil
+}
+
+func (m *ContainerConfig) GetWindows() *WindowsContainerConfig {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+type CreateContainerRequest struct {
+	// ID of the PodSandbox in which the container should be created.
+	PodSandboxId string `protobuf:"bytes,1,opt,name=pod_sandbox_id" json:"pod_sandbox_id,omitempty"`
+	// Config of the container.
+	Config *ContainerConfig `protobuf:"bytes,2,opt,name=config" json:"config,omitempty"`
+	// Config of the PodSandbox. This is the same config that was passed
+	// to RunPodSandboxRequest to create the PodSandbox. It is passed again
+	// here just for easy reference. The PodSandboxConfig is immutable and
+	// remains the same throughout the lifetime of the pod.
+	SandboxConfig *PodSandboxConfig `protobuf:"bytes,3,opt,name=sandbox_config" json:"sandbox_config,omitempty"`
+}
+
+func (m *CreateContainerRequest) Reset()                    { *m = CreateContainerRequest{} }
+func (m *CreateContainerRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateContainerRequest) ProtoMessage()               {}
+func (*CreateContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *CreateContainerRequest) GetConfig() *ContainerConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *CreateContainerRequest) GetSandboxConfig() *PodSandboxConfig {
+	if m != nil {
+		return m.SandboxConfig
+	}
+	return nil
+}
+
+type CreateContainerResponse struct {
+	// ID of the created container.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+}
+
+func (m *CreateContainerResponse) Reset()                    { *m = CreateContainerResponse{} }
+func (m *CreateContainerResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateContainerResponse) ProtoMessage()               {}
+func (*CreateContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+type StartContainerRequest struct {
+	// ID of the container to start.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+}
+
+func (m *StartContainerRequest) Reset()                    { *m = StartContainerRequest{} }
+func (m *StartContainerRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartContainerRequest) ProtoMessage()               {}
+func (*StartContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+type StartContainerResponse struct {
+}
+
+func (m *StartContainerResponse) Reset()                    { *m = StartContainerResponse{} }
+func (m *StartContainerResponse) String() string            { return proto.CompactTextString(m) }
+func (*StartContainerResponse) ProtoMessage()               {}
+func (*StartContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+type StopContainerRequest struct {
+	// ID of the container to stop.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+	// Timeout in seconds to wait for the container to stop before forcibly
+	// terminating it. Default: 0 (forcibly terminate the container immediately)
+	Timeout int64 `protobuf:"varint,2,opt,name=timeout" json:"timeout,omitempty"`
+}
+
+func (m *StopContainerRequest) Reset()                    { *m = StopContainerRequest{} }
+func (m *StopContainerRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopContainerRequest) ProtoMessage()               {}
+func (*StopContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+type StopContainerResponse struct {
+}
+
+func (m *StopContainerResponse) Reset()                    { *m = StopContainerResponse{} }
+func (m *StopContainerResponse) String() string            { return proto.CompactTextString(m) }
+func (*StopContainerResponse) ProtoMessage()               {}
+func (*StopContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+type RemoveContainerRequest struct {
+	// ID of the container to remove.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+}
+
+func (m *RemoveContainerRequest) Reset()                    { *m = RemoveContainerRequest{} }
+func (m *RemoveContainerRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemoveContainerRequest) ProtoMessage()               {}
+func (*RemoveContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+type RemoveContainerResponse struct {
+}
+
+func (m *RemoveContainerResponse) Reset()                    { *m = RemoveContainerResponse{} }
+func (m *RemoveContainerResponse) String() string            { return proto.CompactTextString(m) }
+func (*RemoveContainerResponse) ProtoMessage()               {}
+func (*RemoveContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+// ContainerStateValue is the wrapper of ContainerState.
+type ContainerStateValue struct {
+	// State of the container.
+	State ContainerState `protobuf:"varint,1,opt,name=state,enum=runtime.v1alpha2.ContainerState" json:"state,omitempty"`
+}
+
+func (m *ContainerStateValue) Reset()                    { *m = ContainerStateValue{} }
+func (m *ContainerStateValue) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStateValue) ProtoMessage()               {}
+func (*ContainerStateValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+// ContainerFilter is used to filter containers.
+// All those fields are combined with 'AND'
+type ContainerFilter struct {
+	// ID of the container.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// State of the container.
+	State *ContainerStateValue `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
+	// ID of the PodSandbox.
+	PodSandboxId string `protobuf:"bytes,3,opt,name=pod_sandbox_id" json:"pod_sandbox_id,omitempty"`
+	// LabelSelector to select matches.
+	// Only api.MatchLabels is supported for now and the requirements
+	// are ANDed. MatchExpressions is not supported yet.
+	LabelSelector map[string]string `protobuf:"bytes,4,rep,name=label_selector" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ContainerFilter) Reset()                    { *m = ContainerFilter{} }
+func (m *ContainerFilter) String() string            { return proto.CompactTextString(m) }
+func (*ContainerFilter) ProtoMessage()               {}
+func (*ContainerFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *ContainerFilter) GetState() *ContainerStateValue {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ContainerFilter) GetLabelSelector() map[string]string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
+}
+
+type ListContainersRequest struct {
+	Filter *ContainerFilter `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+}
+
+func (m *ListContainersRequest) Reset()                    { *m = ListContainersRequest{} }
+func (m *ListContainersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListContainersRequest) ProtoMessage()               {}
+func (*ListContainersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *ListContainersRequest) GetFilter() *ContainerFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+// Container provides the runtime information for a container, such as ID, hash,
+// state of the container.
+type Container struct {
+	// ID of the container, used by the container runtime to identify
+	// a container.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// ID of the sandbox to which this container belongs.
+	PodSandboxId string `protobuf:"bytes,2,opt,name=pod_sandbox_id" json:"pod_sandbox_id,omitempty"`
+	// Metadata of the container.
+	Metadata *ContainerMetadata `protobuf:"bytes,3,opt,name=metadata" json:"metadata,omitempty"`
+	// Spec of the image.
+	Image *ImageSpec `protobuf:"bytes,4,opt,name=image" json:"image,omitempty"`
+	// Reference to the image in use. For most runtimes, this should be an
+	// image ID.
+	ImageRef string `protobuf:"bytes,5,opt,name=image_ref" json:"image_ref,omitempty"`
+	// State of the container.
+	State ContainerState `protobuf:"varint,6,opt,name=state,enum=runtime.v1alpha2.ContainerState" json:"state,omitempty"`
+	// Creation time of the container in nanoseconds.
+	CreatedAt int64 `protobuf:"varint,7,opt,name=created_at" json:"created_at,omitempty"`
+	// Key-value pairs that may be used to scope and select individual resources.
+	Labels map[string]string `protobuf:"bytes,8,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Unstructured key-value map holding arbitrary metadata.
+	// Annotations MUST NOT be altered by the runtime; the value of this field
+	// MUST be identical to that of the corresponding ContainerConfig used to
+	// instantiate this Container.
+	Annotations map[string]string `protobuf:"bytes,9,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *Container) Reset()                    { *m = Container{} }
+func (m *Container) String() string            { return proto.CompactTextString(m) }
+func (*Container) ProtoMessage()               {}
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *Container) GetMetadata() *ContainerMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Container) GetImage() *ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *Container) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Container) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+type ListContainersResponse struct {
+	// List of containers.
+	Containers []*Container `protobuf:"bytes,1,rep,name=containers" json:"containers,omitempty"`
+}
+
+func (m *ListContainersResponse) Reset()                    { *m = ListContainersResponse{} }
+func (m *ListContainersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListContainersResponse) ProtoMessage()               {}
+func (*ListContainersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *ListContainersResponse) GetContainers() []*Container {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+type ContainerStatusRequest struct {
+	// ID of the container for which to retrieve status.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+	// Verbose indicates whether to return extra information about the container.
+	Verbose bool `protobuf:"varint,2,opt,name=verbose" json:"verbose,omitempty"`
+}
+
+func (m *ContainerStatusRequest) Reset()                    { *m = ContainerStatusRequest{} }
+func (m *ContainerStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStatusRequest) ProtoMessage()               {}
+func (*ContainerStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+// ContainerStatus represents the status of a container.
+type ContainerStatus struct {
+	// ID of the container.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Metadata of the container.
+	Metadata *ContainerMetadata `protobuf:"bytes,2,opt,name=metadata" json:"metadata,omitempty"`
+	// Status of the container.
+	State ContainerState `protobuf:"varint,3,opt,name=state,enum=runtime.v1alpha2.ContainerState" json:"state,omitempty"`
+	// Creation time of the container in nanoseconds.
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at" json:"created_at,omitempty"`
+	// Start time of the container in nanoseconds. Default: 0 (not specified).
+	StartedAt int64 `protobuf:"varint,5,opt,name=started_at" json:"started_at,omitempty"`
+	// Finish time of the container in nanoseconds. Default: 0 (not specified).
+	FinishedAt int64 `protobuf:"varint,6,opt,name=finished_at" json:"finished_at,omitempty"`
+	// Exit code of the container. Only required when finished_at != 0. Default: 0.
+	ExitCode int32 `protobuf:"varint,7,opt,name=exit_code" json:"exit_code,omitempty"`
+	// Spec of the image.
+	Image *ImageSpec `protobuf:"bytes,8,opt,name=image" json:"image,omitempty"`
+	// Reference to the image in use. For most runtimes, this should be an
+	// image ID
+	ImageRef string `protobuf:"bytes,9,opt,name=image_ref" json:"image_ref,omitempty"`
+	// Brief CamelCase string explaining why container is in its current state.
+	Reason string `protobuf:"bytes,10,opt,name=reason" json:"reason,omitempty"`
+	// Human-readable message indicating details about why container is in its
+	// current state.
+	Message string `protobuf:"bytes,11,opt,name=message" json:"message,omitempty"`
+	// Key-value pairs that may be used to scope and select individual resources.
+	Labels map[string]string `protobuf:"bytes,12,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Unstructured key-value map holding arbitrary metadata.
+	// Annotations MUST NOT be altered by the runtime; the value of this field
+	// MUST be identical to that of the corresponding ContainerConfig used to
+	// instantiate the Container this status represents.
+	Annotations map[string]string `protobuf:"bytes,13,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Mounts for the container.
+	Mounts []*Mount `protobuf:"bytes,14,rep,name=mounts" json:"mounts,omitempty"`
+	// Log path of container.
+	LogPath string `protobuf:"bytes,15,opt,name=log_path" json:"log_path,omitempty"`
+}
+
+func (m *ContainerStatus) Reset()                    { *m = ContainerStatus{} }
+func (m *ContainerStatus) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStatus) ProtoMessage()               {}
+func (*ContainerStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *ContainerStatus) GetMetadata() *ContainerMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ContainerStatus) GetImage() *ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *ContainerStatus) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ContainerStatus) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *ContainerStatus) GetMounts() []*Mount {
+	if m != nil {
+		return m.Mounts
+	}
+	return nil
+}
+
+type ContainerStatusResponse struct {
+	// Status of the container.
+	Status *ContainerStatus `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+	// Info is extra information of the Container. The key could be arbitrary string, and
+	// value should be in json format. The information could include anything useful for
+	// debug, e.g. pid for linux container based container runtime.
+	// It should only be returned non-empty when Verbose is true.
+	Info map[string]string `protobuf:"bytes,2,rep,name=info" json:"info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ContainerStatusResponse) Reset()                    { *m = ContainerStatusResponse{} }
+func (m *ContainerStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStatusResponse) ProtoMessage()               {}
+func (*ContainerStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *ContainerStatusResponse) GetStatus() *ContainerStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ContainerStatusResponse) GetInfo() map[string]string {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type UpdateContainerResourcesRequest struct {
+	// ID of the container to update.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+	// Resource configuration specific to Linux containers.
+	Linux *LinuxContainerResources `protobuf:"bytes,2,opt,name=linux" json:"linux,omitempty"`
+	// Resource configuration specific to Windows containers.
+	Windows *WindowsContainerResources `protobuf:"bytes,3,opt,name=windows" json:"windows,omitempty"`
+	// Unstructured key-value map holding arbitrary additional information for
+	// container resources updating. This can be used for specifying experimental
+	// resources to update or other options to use when updating the container.
+	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *UpdateContainerResourcesRequest) Reset()         { *m = UpdateContainerResourcesRequest{} }
+func (m *UpdateContainerResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateContainerResourcesRequest) ProtoMessage()    {}
+func (*UpdateContainerResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{60}
+}
+
+func (m *UpdateContainerResourcesRequest) GetLinux() *LinuxContainerResources {
+	if m != nil {
+		return m.Linux
+	}
+	return nil
+}
+
+func (m *UpdateContainerResourcesRequest) GetWindows() *WindowsContainerResources {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+func (m *UpdateContainerResourcesRequest) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+type UpdateContainerResourcesResponse struct {
+}
+
+func (m *UpdateContainerResourcesResponse) Reset()         { *m = UpdateContainerResourcesResponse{} }
+func (m *UpdateContainerResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateContainerResourcesResponse) ProtoMessage()    {}
+func (*UpdateContainerResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{61}
+}
+
+type ExecSyncRequest struct {
+	// ID of the container.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+	// Command to execute.
+	Cmd []string `protobuf:"bytes,2,rep,name=cmd" json:"cmd,omitempty"`
+	// Timeout in seconds to stop the command. Default: 0 (run forever).
+	Timeout int64 `protobuf:"varint,3,opt,name=timeout" json:"timeout,omitempty"`
+}
+
+func (m *ExecSyncRequest) Reset()                    { *m = ExecSyncRequest{} }
+func (m *ExecSyncRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecSyncRequest) ProtoMessage()               {}
+func (*ExecSyncRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+type ExecSyncResponse struct {
+	// Captured command stdout output.
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	// Captured command stderr output.
+	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// Exit code the command finished with. Default: 0 (success).
+	ExitCode int32 `protobuf:"varint,3,opt,name=exit_code" json:"exit_code,omitempty"`
+}
+
+func (m *ExecSyncResponse) Reset()                    { *m = ExecSyncResponse{} }
+func (m *ExecSyncResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecSyncResponse) ProtoMessage()               {}
+func (*ExecSyncResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+type ExecRequest struct {
+	// ID of the container in which to execute the command.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+	// Command to execute.
+	Cmd []string `protobuf:"bytes,2,rep,name=cmd" json:"cmd,omitempty"`
+	// Whether to exec the command in a TTY.
+	Tty bool `protobuf:"varint,3,opt,name=tty" json:"tty,omitempty"`
+	// Whether to stream stdin.
+	// One of `stdin`, `stdout`, and `stderr` MUST be true.
+	Stdin bool `protobuf:"varint,4,opt,name=stdin" json:"stdin,omitempty"`
+	// Whether to stream stdout.
+	// One of `stdin`, `stdout`, and `stderr` MUST be true.
+	Stdout bool `protobuf:"varint,5,opt,name=stdout" json:"stdout,omitempty"`
+	// Whether to stream stderr.
+	// One of `stdin`, `stdout`, and `stderr` MUST be true.
+	// If `tty` is true, `stderr` MUST be false. Multiplexing is not supported
+	// in this case. The output of stdout and stderr will be combined to a
+	// single stream.
+	Stderr bool `protobuf:"varint,6,opt,name=stderr" json:"stderr,omitempty"`
+}
+
+func (m *ExecRequest) Reset()                    { *m = ExecRequest{} }
+func (m *ExecRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecRequest) ProtoMessage()               {}
+func (*ExecRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+type ExecResponse struct {
+	// Fully qualified URL of the exec streaming server.
+	Url string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+}
+
+func (m *ExecResponse) Reset()                    { *m = ExecResponse{} }
+func (m *ExecResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecResponse) ProtoMessage()               {}
+func (*ExecResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+type AttachRequest struct {
+	// ID of the container to which to attach.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+	// Whether to stream stdin.
+	// One of `stdin`, `stdout`, and `stderr` MUST be true.
+	Stdin bool `protobuf:"varint,2,opt,name=stdin" json:"stdin,omitempty"`
+	// Whether the process being attached is running in a TTY.
+	// This must match the TTY setting in the ContainerConfig.
+	Tty bool `protobuf:"varint,3,opt,name=tty" json:"tty,omitempty"`
+	// Whether to stream stdout.
+	// One of `stdin`, `stdout`, and `stderr` MUST be true.
+	Stdout bool `protobuf:"varint,4,opt,name=stdout" json:"stdout,omitempty"`
+	// Whether to stream stderr.
+	// One of `stdin`, `stdout`, and `stderr` MUST be true.
+	// If `tty` is true, `stderr` MUST be false. Multiplexing is not supported
+	// in this case. The output of stdout and stderr will be combined to a
+	// single stream.
+	Stderr bool `protobuf:"varint,5,opt,name=stderr" json:"stderr,omitempty"`
+}
+
+func (m *AttachRequest) Reset()                    { *m = AttachRequest{} }
+func (m *AttachRequest) String() string            { return proto.CompactTextString(m) }
+func (*AttachRequest) ProtoMessage()               {}
+func (*AttachRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+type AttachResponse struct {
+	// Fully qualified URL of the attach streaming server.
+	Url string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+}
+
+func (m *AttachResponse) Reset()                    { *m = AttachResponse{} }
+func (m *AttachResponse) String() string            { return proto.CompactTextString(m) }
+func (*AttachResponse) ProtoMessage()               {}
+func (*AttachResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+type PortForwardRequest struct {
+	// ID of the container to which to forward the port.
+	PodSandboxId string `protobuf:"bytes,1,opt,name=pod_sandbox_id" json:"pod_sandbox_id,omitempty"`
+	// Port to forward.
+	Port []int32 `protobuf:"varint,2,rep,name=port" json:"port,omitempty"`
+}
+
+func (m *PortForwardRequest) Reset()                    { *m = PortForwardRequest{} }
+func (m *PortForwardRequest) String() string            { return proto.CompactTextString(m) }
+func (*PortForwardRequest) ProtoMessage()               {}
+func (*PortForwardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+type PortForwardResponse struct {
+	// Fully qualified URL of the port-forward streaming server.
+	Url string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+}
+
+func (m *PortForwardResponse) Reset()                    { *m = PortForwardResponse{} }
+func (m *PortForwardResponse) String() string            { return proto.CompactTextString(m) }
+func (*PortForwardResponse) ProtoMessage()               {}
+func (*PortForwardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+type ImageFilter struct {
+	// Spec of the image.
+	Image *ImageSpec `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+}
+
+func (m *ImageFilter) Reset()                    { *m = ImageFilter{} }
+func (m *ImageFilter) String() string            { return proto.CompactTextString(m) }
+func (*ImageFilter) ProtoMessage()               {}
+func (*ImageFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *ImageFilter) GetImage() *ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+type ListImagesRequest struct {
+	// Filter to list images.
+	Filter *ImageFilter `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+}
+
+func (m *ListImagesRequest) Reset()                    { *m = ListImagesRequest{} }
+func (m *ListImagesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListImagesRequest) ProtoMessage()               {}
+func (*ListImagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *ListImagesRequest) GetFilter() *ImageFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+// Basic information about a container image.
+type Image struct {
+	// ID of the image.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Other names by which this image is known.
+	RepoTags []string `protobuf:"bytes,2,rep,name=repo_tags" json:"repo_tags,omitempty"`
+	// Digests by which this image is known.
+	RepoDigests []string `protobuf:"bytes,3,rep,name=repo_digests" json:"repo_digests,omitempty"`
+	// Size of the image in bytes. Must be > 0.
+	Size uint64 `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`
+	// UID that will run the command(s). This is used as a default if no user is
+	// specified when creating the container. UID and the following user name
+	// are mutually exclusive.
+	Uid *Int64Value `protobuf:"bytes,5,opt,name=uid" json:"uid,omitempty"`
+	// User name that will run the command(s). This is used if UID is not set
+	// and no user is specified when creating container.
+	Username string `protobuf:"bytes,6,opt,name=username" json:"username,omitempty"`
+	// ImageSpec for image which includes annotations
+	Spec *ImageSpec `protobuf:"bytes,7,opt,name=spec" json:"spec,omitempty"`
+}
+
+func (m *Image) Reset()                    { *m = Image{} }
+func (m *Image) String() string            { return proto.CompactTextString(m) }
+func (*Image) ProtoMessage()               {}
+func (*Image) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *Image) GetUid() *Int64Value {
+	if m != nil {
+		return m.Uid
+	}
+	return nil
+}
+
+func (m *Image) GetSpec() *ImageSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+type ListImagesResponse struct {
+	// List of images.
+	Images []*Image `protobuf:"bytes,1,rep,name=images" json:"images,omitempty"`
+}
+
+func (m *ListImagesResponse) Reset()                    { *m = ListImagesResponse{} }
+func (m *ListImagesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListImagesResponse) ProtoMessage()               {}
+func (*ListImagesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *ListImagesResponse) GetImages() []*Image {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+type ImageStatusRequest struct {
+	// Spec of the image.
+	Image *ImageSpec `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+	// Verbose indicates whether to return extra information about the image.
+	Verbose bool `protobuf:"varint,2,opt,name=verbose" json:"verbose,omitempty"`
+}
+
+func (m *ImageStatusRequest) Reset()                    { *m = ImageStatusRequest{} }
+func (m *ImageStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImageStatusRequest) ProtoMessage()               {}
+func (*ImageStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *ImageStatusRequest) GetImage() *ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+type ImageStatusResponse struct {
+	// Status of the image.
+	Image *Image `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+	// Info is extra information of the Image. The key could be arbitrary string, and
+	// value should be in json format. The information could include anything useful
+	// for debug, e.g. image config for oci image based container runtime.
+	// It should only be returned non-empty when Verbose is true.
+	Info map[string]string `protobuf:"bytes,2,rep,name=info" json:"info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ImageStatusResponse) Reset()                    { *m = ImageStatusResponse{} }
+func (m *ImageStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImageStatusResponse) ProtoMessage()               {}
+func (*ImageStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *ImageStatusResponse) GetImage() *Image {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *ImageStatusResponse) GetInfo() map[string]string {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+// AuthConfig contains authorization information for connecting to a registry.
+type AuthConfig struct {
+	Username      string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Password      string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+	Auth          string `protobuf:"bytes,3,opt,name=auth" json:"auth,omitempty"`
+	ServerAddress string `protobuf:"bytes,4,opt,name=server_address" json:"server_address,omitempty"`
+	// IdentityToken is used to authenticate the user and get
+	// an access token for the registry.
+	IdentityToken string `protobuf:"bytes,5,opt,name=identity_token" json:"identity_token,omitempty"`
+	// RegistryToken is a bearer token to be sent to a registry
+	RegistryToken string `protobuf:"bytes,6,opt,name=registry_token" json:"registry_token,omitempty"`
+}
+
+func (m *AuthConfig) Reset()                    { *m = AuthConfig{} }
+func (m *AuthConfig) String() string            { return proto.CompactTextString(m) }
+func (*AuthConfig) ProtoMessage()               {}
+func (*AuthConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+type PullImageRequest struct {
+	// Spec of the image.
+	Image *ImageSpec `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+	// Authentication configuration for pulling the image.
+	Auth *AuthConfig `protobuf:"bytes,2,opt,name=auth" json:"auth,omitempty"`
+	// Config of the PodSandbox, which is used to pull image in PodSandbox context.
+	SandboxConfig *PodSandboxConfig `protobuf:"bytes,3,opt,name=sandbox_config" json:"sandbox_config,omitempty"`
+}
+
+func (m *PullImageRequest) Reset()                    { *m = PullImageRequest{} }
+func (m *PullImageRequest) String() string            { return proto.CompactTextString(m) }
+func (*PullImageRequest) ProtoMessage()               {}
+func (*PullImageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *PullImageRequest) GetImage() *ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *PullImageRequest) GetAuth() *AuthConfig {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+func (m *PullImageRequest) GetSandboxConfig() *PodSandboxConfig {
+	if m != nil {
+		return m.SandboxConfig
+	}
+	return nil
+}
+
+type PullImageResponse struct {
+	// Reference to the image in use. For most runtimes, this should be an
+	// image ID or digest.
+	ImageRef string `protobuf:"bytes,1,opt,name=image_ref" json:"image_ref,omitempty"`
+}
+
+func (m *PullImageResponse) Reset()                    { *m = PullImageResponse{} }
+func (m *PullImageResponse) String() string            { return proto.CompactTextString(m) }
+func (*PullImageResponse) ProtoMessage()               {}
+func (*PullImageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+type RemoveImageRequest struct {
+	// Spec of the image to remove.
+	Image *ImageSpec `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+}
+
+func (m *RemoveImageRequest) Reset()                    { *m = RemoveImageRequest{} }
+func (m *RemoveImageRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemoveImageRequest) ProtoMessage()               {}
+func (*RemoveImageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *RemoveImageRequest) GetImage() *ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+type RemoveImageResponse struct {
+}
+
+func (m *RemoveImageResponse) Reset()                    { *m = RemoveImageResponse{} }
+func (m *RemoveImageResponse) String() string            { return proto.CompactTextString(m) }
+func (*RemoveImageResponse) ProtoMessage()               {}
+func (*RemoveImageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+type NetworkConfig struct {
+	// CIDR to use for pod IP addresses. If the CIDR is empty, runtimes
+	// should omit it.
+	PodCidr string `protobuf:"bytes,1,opt,name=pod_cidr" json:"pod_cidr,omitempty"`
+}
+
+func (m *NetworkConfig) Reset()                    { *m = NetworkConfig{} }
+func (m *NetworkConfig) String() string            { return proto.CompactTextString(m) }
+func (*NetworkConfig) ProtoMessage()               {}
+func (*NetworkConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+type RuntimeConfig struct {
+	NetworkConfig *NetworkConfig `protobuf:"bytes,1,opt,name=network_config" json:"network_config,omitempty"`
+}
+
+func (m *RuntimeConfig) Reset()                    { *m = RuntimeConfig{} }
+func (m *RuntimeConfig) String() string            { return proto.CompactTextString(m) }
+func (*RuntimeConfig) ProtoMessage()               {}
+func (*RuntimeConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *RuntimeConfig) GetNetworkConfig() *NetworkConfig {
+	if m != nil {
+		return m.NetworkConfig
+	}
+	return nil
+}
+
+type UpdateRuntimeConfigRequest struct {
+	RuntimeConfig *RuntimeConfig `protobuf:"bytes,1,opt,name=runtime_config" json:"runtime_config,omitempty"`
+}
+
+func (m *UpdateRuntimeConfigRequest) Reset()                    { *m = UpdateRuntimeConfigRequest{} }
+func (m *UpdateRuntimeConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateRuntimeConfigRequest) ProtoMessage()               {}
+func (*UpdateRuntimeConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+func (m *UpdateRuntimeConfigRequest) GetRuntimeConfig() *RuntimeConfig {
+	if m != nil {
+		return m.RuntimeConfig
+	}
+	return nil
+}
+
+type UpdateRuntimeConfigResponse struct {
+}
+
+func (m *UpdateRuntimeConfigResponse) Reset()                    { *m = UpdateRuntimeConfigResponse{} }
+func (m *UpdateRuntimeConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateRuntimeConfigResponse) ProtoMessage()               {}
+func (*UpdateRuntimeConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+// RuntimeCondition contains condition information for the runtime.
+// There are 2 kinds of runtime conditions:
+// 1. Required conditions: Conditions are required for kubelet to work
+// properly. If any required condition is unmet, the node will be not ready.
+// The required conditions include:
+//   - RuntimeReady: RuntimeReady means the runtime is up and ready to accept
+//     basic containers e.g. container only needs host network.
+//   - NetworkReady: NetworkReady means the runtime network is up and ready to
+//     accept containers which require container network.
+//
+// 2. Optional conditions: Conditions are informative to the user, but kubelet
+// will not rely on. Since condition type is an arbitrary string, all conditions
+// not required are optional. These conditions will be exposed to users to help
+// them understand the status of the system.
+type RuntimeCondition struct {
+	// Type of runtime condition.
+	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	// Status of the condition, one of true/false. Default: false.
+	Status bool `protobuf:"varint,2,opt,name=status" json:"status,omitempty"`
+	// Brief CamelCase string containing reason for the condition's last transition.
+	Reason string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition.
+	Message string `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RuntimeCondition) Reset()                    { *m = RuntimeCondition{} }
+func (m *RuntimeCondition) String() string            { return proto.CompactTextString(m) }
+func (*RuntimeCondition) ProtoMessage()               {}
+func (*RuntimeCondition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+// RuntimeStatus is information about the current status of the runtime.
+type RuntimeStatus struct {
+	// List of current observed runtime conditions.
+	Conditions []*RuntimeCondition `protobuf:"bytes,1,rep,name=conditions" json:"conditions,omitempty"`
+}
+
+func (m *RuntimeStatus) Reset()                    { *m = RuntimeStatus{} }
+func (m *RuntimeStatus) String() string            { return proto.CompactTextString(m) }
+func (*RuntimeStatus) ProtoMessage()               {}
+func (*RuntimeStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+
+func (m *RuntimeStatus) GetConditions() []*RuntimeCondition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+type StatusRequest struct {
+	// Verbose indicates whether to return extra information about the runtime.
+	Verbose bool `protobuf:"varint,1,opt,name=verbose" json:"verbose,omitempty"`
+}
+
+func (m *StatusRequest) Reset()                    { *m = StatusRequest{} }
+func (m *StatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*StatusRequest) ProtoMessage()               {}
+func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+
+type StatusResponse struct {
+	// Status of the Runtime.
+	Status *RuntimeStatus `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+	// Info is extra information of the Runtime. The key could be arbitrary string, and
+	// value should be in json format. The information could include anything useful for
+	// debug, e.g. plugins used by the container runtime.
+	// It should only be returned non-empty when Verbose is true.
+	Info map[string]string `protobuf:"bytes,2,rep,name=info" json:"info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *StatusResponse) Reset()                    { *m = StatusResponse{} }
+func (m *StatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*StatusResponse) ProtoMessage()               {}
+func (*StatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+func (m *StatusResponse) GetStatus() *RuntimeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *StatusResponse) GetInfo() map[string]string {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ImageFsInfoRequest struct {
+}
+
+func (m *ImageFsInfoRequest) Reset()                    { *m = ImageFsInfoRequest{} }
+func (m *ImageFsInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImageFsInfoRequest) ProtoMessage()               {}
+func (*ImageFsInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+
+// UInt64Value is the wrapper of uint64.
+type UInt64Value struct {
+	// The value.
+	Value uint64 `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *UInt64Value) Reset()                    { *m = UInt64Value{} }
+func (m *UInt64Value) String() string            { return proto.CompactTextString(m) }
+func (*UInt64Value) ProtoMessage()               {}
+func (*UInt64Value) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+
+// FilesystemIdentifier uniquely identify the filesystem.
+type FilesystemIdentifier struct {
+	// Mountpoint of a filesystem.
+	Mountpoint string `protobuf:"bytes,1,opt,name=mountpoint" json:"mountpoint,omitempty"`
+}
+
+func (m *FilesystemIdentifier) Reset()                    { *m = FilesystemIdentifier{} }
+func (m *FilesystemIdentifier) String() string            { return proto.CompactTextString(m) }
+func (*FilesystemIdentifier) ProtoMessage()               {}
+func (*FilesystemIdentifier) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+
+// FilesystemUsage provides the filesystem usage information.
+type FilesystemUsage struct {
+	// Timestamp in nanoseconds at which the information were collected. Must be > 0.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// The unique identifier of the filesystem.
+	FsId *FilesystemIdentifier `protobuf:"bytes,2,opt,name=fs_id" json:"fs_id,omitempty"`
+	// UsedBytes represents the bytes used for images on the filesystem.
+	// This may differ from the total bytes used on the filesystem and may not
+	// equal CapacityBytes - AvailableBytes.
+	UsedBytes *UInt64Value `protobuf:"bytes,3,opt,name=used_bytes" json:"used_bytes,omitempty"`
+	// InodesUsed represents the inodes used by the images.
+	// This may not equal InodesCapacity - InodesAvailable because the underlying
+	// filesystem may also be used for purposes other than storing images.
+	InodesUsed *UInt64Value `protobuf:"bytes,4,opt,name=inodes_used" json:"inodes_used,omitempty"`
+}
+
+func (m *FilesystemUsage) Reset()                    { *m = FilesystemUsage{} }
+func (m *FilesystemUsage) String() string            { return proto.CompactTextString(m) }
+func (*FilesystemUsage) ProtoMessage()               {}
+func (*FilesystemUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+
+func (m *FilesystemUsage) GetFsId() *FilesystemIdentifier {
+	if m != nil {
+		return m.FsId
+	}
+	return nil
+}
+
+func (m *FilesystemUsage) GetUsedBytes() *UInt64Value {
+	if m != nil {
+		return m.UsedBytes
+	}
+	return nil
+}
+
+func (m *FilesystemUsage) GetInodesUsed() *UInt64Value {
+	if m != nil {
+		return m.InodesUsed
+	}
+	return nil
+}
+
+type ImageFsInfoResponse struct {
+	// Information of image filesystem(s).
+	ImageFilesystems []*FilesystemUsage `protobuf:"bytes,1,rep,name=image_filesystems" json:"image_filesystems,omitempty"`
+}
+
+func (m *ImageFsInfoResponse) Reset()                    { *m = ImageFsInfoResponse{} }
+func (m *ImageFsInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImageFsInfoResponse) ProtoMessage()               {}
+func (*ImageFsInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+
+func (m *ImageFsInfoResponse) GetImageFilesystems() []*FilesystemUsage {
+	if m != nil {
+		return m.ImageFilesystems
+	}
+	return nil
+}
+
+type ContainerStatsRequest struct {
+	// ID of the container for which to retrieve stats.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+}
+
+func (m *ContainerStatsRequest) Reset()                    { *m = ContainerStatsRequest{} }
+func (m *ContainerStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStatsRequest) ProtoMessage()               {}
+func (*ContainerStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+type ContainerStatsResponse struct {
+	// Stats of the container.
+	Stats *ContainerStats `protobuf:"bytes,1,opt,name=stats" json:"stats,omitempty"`
+}
+
+func (m *ContainerStatsResponse) Reset()                    { *m = ContainerStatsResponse{} }
+func (m *ContainerStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStatsResponse) ProtoMessage()               {}
+func (*ContainerStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+
+func (m *ContainerStatsResponse) GetStats() *ContainerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type ListContainerStatsRequest struct {
+	// Filter for the list request.
+	Filter *ContainerStatsFilter `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+}
+
+func (m *ListContainerStatsRequest) Reset()                    { *m = ListContainerStatsRequest{} }
+func (m *ListContainerStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListContainerStatsRequest) ProtoMessage()               {}
+func (*ListContainerStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+
+func (m *ListContainerStatsRequest) GetFilter() *ContainerStatsFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+// ContainerStatsFilter is used to filter containers.
+// All those fields are combined with 'AND'
+type ContainerStatsFilter struct {
+	// ID of the container.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// ID of the PodSandbox.
+	PodSandboxId string `protobuf:"bytes,2,opt,name=pod_sandbox_id" json:"pod_sandbox_id,omitempty"`
+	// LabelSelector to select matches.
+	// Only api.MatchLabels is supported for now and the requirements
+	// are ANDed. MatchExpressions is not supported yet.
+	LabelSelector map[string]string `protobuf:"bytes,3,rep,name=label_selector" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ContainerStatsFilter) Reset()                    { *m = ContainerStatsFilter{} }
+func (m *ContainerStatsFilter) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStatsFilter) ProtoMessage()               {}
+func (*ContainerStatsFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *ContainerStatsFilter) GetLabelSelector() map[string]string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
+}
+
+type ListContainerStatsResponse struct {
+	// Stats of the container.
+	Stats []*ContainerStats `protobuf:"bytes,1,rep,name=stats" json:"stats,omitempty"`
+}
+
+func (m *ListContainerStatsResponse) Reset()                    { *m = ListContainerStatsResponse{} }
+func (m *ListContainerStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListContainerStatsResponse) ProtoMessage()               {}
+func (*ListContainerStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+
+func (m *ListContainerStatsResponse) GetStats() []*ContainerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// ContainerAttributes provides basic information of the container.
+type ContainerAttributes struct {
+	// ID of the container.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Metadata of the container.
+	Metadata *ContainerMetadata `protobuf:"bytes,2,opt,name=metadata" json:"metadata,omitempty"`
+	// Key-value pairs that may be used to scope and select individual resources.
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Unstructured key-value map holding arbitrary metadata.
+	// Annotations MUST NOT be altered by the runtime; the value of this field
+	// MUST be identical to that of the corresponding ContainerConfig used to
+	// instantiate the Container this status represents.
+	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ContainerAttributes) Reset()                    { *m = ContainerAttributes{} }
+func (m *ContainerAttributes) String() string            { return proto.CompactTextString(m) }
+func (*ContainerAttributes) ProtoMessage()               {}
+func (*ContainerAttributes) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+
+func (m *ContainerAttributes) GetMetadata() *ContainerMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ContainerAttributes) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ContainerAttributes) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+// ContainerStats provides the resource usage statistics for a container.
+type ContainerStats struct {
+	// Information of the container.
+	Attributes *ContainerAttributes `protobuf:"bytes,1,opt,name=attributes" json:"attributes,omitempty"`
+	// CPU usage gathered from the container.
+	Cpu *CpuUsage `protobuf:"bytes,2,opt,name=cpu" json:"cpu,omitempty"`
+	// Memory usage gathered from the container.
+	Memory *MemoryUsage `protobuf:"bytes,3,opt,name=memory" json:"memory,omitempty"`
+	// Usage of the writable layer.
+	WritableLayer *FilesystemUsage `protobuf:"bytes,4,opt,name=writable_layer" json:"writable_layer,omitempty"`
+}
+
+func (m *ContainerStats) Reset()                    { *m = ContainerStats{} }
+func (m *ContainerStats) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStats) ProtoMessage()               {}
+func (*ContainerStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+
+func (m *ContainerStats) GetAttributes() *ContainerAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *ContainerStats) GetCpu() *CpuUsage {
+	if m != nil {
+		return m.Cpu
+	}
+	return nil
+}
+
+func (m *ContainerStats) GetMemory() *MemoryUsage {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+func (m *ContainerStats) GetWritableLayer() *FilesystemUsage {
+	if m != nil {
+		return m.WritableLayer
+	}
+	return nil
+}
+
+// CpuUsage provides the CPU usage information.
+type CpuUsage struct {
+	// Timestamp in nanoseconds at which the information were collected. Must be > 0.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// Cumulative CPU usage (sum across all cores) since object creation.
+	UsageCoreNanoSeconds *UInt64Value `protobuf:"bytes,2,opt,name=usage_core_nano_seconds" json:"usage_core_nano_seconds,omitempty"`
+}
+
+func (m *CpuUsage) Reset()                    { *m = CpuUsage{} }
+func (m *CpuUsage) String() string            { return proto.CompactTextString(m) }
+func (*CpuUsage) ProtoMessage()               {}
+func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+
+func (m *CpuUsage) GetUsageCoreNanoSeconds() *UInt64Value {
+	if m != nil {
+		return m.UsageCoreNanoSeconds
+	}
+	return nil
+}
+
+// MemoryUsage provides the memory usage information.
+type MemoryUsage struct {
+	// Timestamp in nanoseconds at which the information were collected. Must be > 0.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// The amount of working set memory in bytes.
+	WorkingSetBytes *UInt64Value `protobuf:"bytes,2,opt,name=working_set_bytes" json:"working_set_bytes,omitempty"`
+}
+
+func (m *MemoryUsage) Reset()                    { *m = MemoryUsage{} }
+func (m *MemoryUsage) String() string            { return proto.CompactTextString(m) }
+func (*MemoryUsage) ProtoMessage()               {}
+func (*MemoryUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+
+func (m *MemoryUsage) GetWorkingSetBytes() *UInt64Value {
+	if m != nil {
+		return m.WorkingSetBytes
+	}
+	return nil
+}
+
+type ReopenContainerLogRequest struct {
+	// ID of the container for which to reopen the log.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id" json:"container_id,omitempty"`
+}
+
+func (m *ReopenContainerLogRequest) Reset()                    { *m = ReopenContainerLogRequest{} }
+func (m *ReopenContainerLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReopenContainerLogRequest) ProtoMessage()               {}
+func (*ReopenContainerLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+
+type ReopenContainerLogResponse struct {
+}
+
+func (m *ReopenContainerLogResponse) Reset()                    { *m = ReopenContainerLogResponse{} }
+func (m *ReopenContainerLogResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReopenContainerLogResponse) ProtoMessage()               {}
+func (*ReopenContainerLogResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+
+func init() {
+	proto.RegisterType((*VersionRequest)(nil), "runtime.v1alpha2.VersionRequest")
+	proto.RegisterType((*VersionResponse)(nil), "runtime.v1alpha2.VersionResponse")
+	proto.RegisterType((*DNSConfig)(nil), "runtime.v1alpha2.DNSConfig")
+	proto.RegisterType((*PortMapping)(nil), "runtime.v1alpha2.PortMapping")
+	proto.RegisterType((*Mount)(nil), "runtime.v1alpha2.Mount")
+	proto.RegisterType((*NamespaceOption)(nil), "runtime.v1alpha2.NamespaceOption")
+	proto.RegisterType((*Int64Value)(nil), "runtime.v1alpha2.Int64Value")
+	proto.RegisterType((*LinuxSandboxSecurityContext)(nil), "runtime.v1alpha2.LinuxSandboxSecurityContext")
+	proto.RegisterType((*SecurityProfile)(nil), "runtime.v1alpha2.SecurityProfile")
+	proto.RegisterType((*LinuxPodSandboxConfig)(nil), "runtime.v1alpha2.LinuxPodSandboxConfig")
+	proto.RegisterType((*PodSandboxMetadata)(nil), "runtime.v1alpha2.PodSandboxMetadata")
+	proto.RegisterType((*PodSandboxConfig)(nil), "runtime.v1alpha2.PodSandboxConfig")
+	proto.RegisterType((*RunPodSandboxRequest)(nil), "runtime.v1alpha2.RunPodSandboxRequest")
+	proto.RegisterType((*RunPodSandboxResponse)(nil), "runtime.v1alpha2.RunPodSandboxResponse")
+	proto.RegisterType((*StopPodSandboxRequest)(nil), "runtime.v1alpha2.StopPodSandboxRequest")
+	proto.RegisterType((*StopPodSandboxResponse)(nil), "runtime.v1alpha2.StopPodSandboxResponse")
+	proto.RegisterType((*RemovePodSandboxRequest)(nil), "runtime.v1alpha2.RemovePodSandboxRequest")
+	proto.RegisterType((*RemovePodSandboxResponse)(nil), "runtime.v1alpha2.RemovePodSandboxResponse")
+	proto.RegisterType((*PodSandboxStatusRequest)(nil), "runtime.v1alpha2.PodSandboxStatusRequest")
+	proto.RegisterType((*PodIP)(nil), "runtime.v1alpha2.PodIP")
+	proto.RegisterType((*PodSandboxNetworkStatus)(nil), "runtime.v1alpha2.PodSandboxNetworkStatus")
+	proto.RegisterType((*Namespace)(nil), "runtime.v1alpha2.Namespace")
+	proto.RegisterType((*LinuxPodSandboxStatus)(nil), "runtime.v1alpha2.LinuxPodSandboxStatus")
+	proto.RegisterType((*PodSandboxStatus)(nil), "runtime.v1alpha2.PodSandboxStatus")
+	proto.RegisterType((*PodSandboxStatusResponse)(nil), "runtime.v1alpha2.PodSandboxStatusResponse")
+	proto.RegisterType((*PodSandboxStateValue)(nil), "runtime.v1alpha2.PodSandboxStateValue")
+	proto.RegisterType((*PodSandboxFilter)(nil), "runtime.v1alpha2.PodSandboxFilter")
+	proto.RegisterType((*ListPodSandboxRequest)(nil), "runtime.v1alpha2.ListPodSandboxRequest")
+	proto.RegisterType((*PodSandbox)(nil), "runtime.v1alpha2.PodSandbox")
+	proto.RegisterType((*ListPodSandboxResponse)(nil), "runtime.v1alpha2.ListPodSandboxResponse")
+	proto.RegisterType((*ImageSpec)(nil), "runtime.v1alpha2.ImageSpec")
+	proto.RegisterType((*KeyValue)(nil), "runtime.v1alpha2.KeyValue")
+	proto.RegisterType((*LinuxContainerResources)(nil), "runtime.v1alpha2.LinuxContainerResources")
+	proto.RegisterType((*HugepageLimit)(nil), "runtime.v1alpha2.HugepageLimit")
+	proto.RegisterType((*SELinuxOption)(nil), "runtime.v1alpha2.SELinuxOption")
+	proto.RegisterType((*Capability)(nil), "runtime.v1alpha2.Capability")
+	proto.RegisterType((*LinuxContainerSecurityContext)(nil), "runtime.v1alpha2.LinuxContainerSecurityContext")
+	proto.RegisterType((*LinuxContainerConfig)(nil), "runtime.v1alpha2.LinuxContainerConfig")
+	proto.RegisterType((*WindowsContainerSecurityContext)(nil), "runtime.v1alpha2.WindowsContainerSecurityContext")
+	proto.RegisterType((*WindowsContainerConfig)(nil), "runtime.v1alpha2.WindowsContainerConfig")
+	proto.RegisterType((*WindowsContainerResources)(nil), "runtime.v1alpha2.WindowsContainerResources")
+	proto.RegisterType((*ContainerMetadata)(nil), "runtime.v1alpha2.ContainerMetadata")
+	proto.RegisterType((*Device)(nil), "runtime.v1alpha2.Device")
+	proto.RegisterType((*ContainerConfig)(nil), "runtime.v1alpha2.ContainerConfig")
+	proto.RegisterType((*CreateContainerRequest)(nil), "runtime.v1alpha2.CreateContainerRequest")
+	proto.RegisterType((*CreateContainerResponse)(nil), "runtime.v1alpha2.CreateContainerResponse")
+	proto.RegisterType((*StartContainerRequest)(nil), "runtime.v1alpha2.StartContainerRequest")
+	proto.RegisterType((*StartContainerResponse)(nil), "runtime.v1alpha2.StartContainerResponse")
+	proto.RegisterType((*StopContainerRequest)(nil), "runtime.v1alpha2.StopContainerRequest")
+	proto.RegisterType((*StopContainerResponse)(nil), "runtime.v1alpha2.StopContainerResponse")
+	proto.RegisterType((*RemoveContainerRequest)(nil), "runtime.v1alpha2.RemoveContainerRequest")
+	proto.RegisterType((*RemoveContainerResponse)(nil), "runtime.v1alpha2.RemoveContainerResponse")
+	proto.RegisterType((*ContainerStateValue)(nil), "runtime.v1alpha2.ContainerStateValue")
+	proto.RegisterType((*ContainerFilter)(nil), "runtime.v1alpha2.ContainerFilter")
+	proto.RegisterType((*ListContainersRequest)(nil), "runtime.v1alpha2.ListContainersRequest")
+	proto.RegisterType((*Container)(nil), "runtime.v1alpha2.Container")
+	proto.RegisterType((*ListContainersResponse)(nil), "runtime.v1alpha2.ListContainersResponse")
+	proto.RegisterType((*ContainerStatusRequest)(nil), "runtime.v1alpha2.ContainerStatusRequest")
+	proto.RegisterType((*ContainerStatus)(nil), "runtime.v1alpha2.ContainerStatus")
+	proto.RegisterType((*ContainerStatusResponse)(nil), "runtime.v1alpha2.ContainerStatusResponse")
+	proto.RegisterType((*UpdateContainerResourcesRequest)(nil), "runtime.v1alpha2.UpdateContainerResourcesRequest")
+	proto.RegisterType((*UpdateContainerResourcesResponse)(nil), "runtime.v1alpha2.UpdateContainerResourcesResponse")
+	proto.RegisterType((*ExecSyncRequest)(nil), "runtime.v1alpha2.ExecSyncRequest")
+	proto.RegisterType((*ExecSyncResponse)(nil), "runtime.v1alpha2.ExecSyncResponse")
+	proto.RegisterType((*ExecRequest)(nil), "runtime.v1alpha2.ExecRequest")
+	proto.RegisterType((*ExecResponse)(nil), "runtime.v1alpha2.ExecResponse")
+	proto.RegisterType((*AttachRequest)(nil), "runtime.v1alpha2.AttachRequest")
+	proto.RegisterType((*AttachResponse)(nil), "runtime.v1alpha2.AttachResponse")
+	proto.RegisterType((*PortForwardRequest)(nil), "runtime.v1alpha2.PortForwardRequest")
+	proto.RegisterType((*PortForwardResponse)(nil), "runtime.v1alpha2.PortForwardResponse")
+	proto.RegisterType((*ImageFilter)(nil), "runtime.v1alpha2.ImageFilter")
+	proto.RegisterType((*ListImagesRequest)(nil), "runtime.v1alpha2.ListImagesRequest")
+	proto.RegisterType((*Image)(nil), "runtime.v1alpha2.Image")
+	proto.RegisterType((*ListImagesResponse)(nil), "runtime.v1alpha2.ListImagesResponse")
+	proto.RegisterType((*ImageStatusRequest)(nil), "runtime.v1alpha2.ImageStatusRequest")
+	proto.RegisterType((*ImageStatusResponse)(nil), "runtime.v1alpha2.ImageStatusResponse")
+	proto.RegisterType((*AuthConfig)(nil), "runtime.v1alpha2.AuthConfig")
+	proto.RegisterType((*PullImageRequest)(nil), "runtime.v1alpha2.PullImageRequest")
+	proto.RegisterType((*PullImageResponse)(nil), "runtime.v1alpha2.PullImageResponse")
+	proto.RegisterType((*RemoveImageRequest)(nil), "runtime.v1alpha2.RemoveImageRequest")
+	proto.RegisterType((*RemoveImageResponse)(nil), "runtime.v1alpha2.RemoveImageResponse")
+	proto.RegisterType((*NetworkConfig)(nil), "runtime.v1alpha2.NetworkConfig")
+	proto.RegisterType((*RuntimeConfig)(nil), "runtime.v1alpha2.RuntimeConfig")
+	proto.RegisterType((*UpdateRuntimeConfigRequest)(nil), "runtime.v1alpha2.UpdateRuntimeConfigRequest")
+	proto.RegisterType((*UpdateRuntimeConfigResponse)(nil), "runtime.v1alpha2.UpdateRuntimeConfigResponse")
+	proto.RegisterType((*RuntimeCondition)(nil), "runtime.v1alpha2.RuntimeCondition")
+	proto.RegisterType((*RuntimeStatus)(nil), "runtime.v1alpha2.RuntimeStatus")
+	proto.RegisterType((*StatusRequest)(nil), "runtime.v1alpha2.StatusRequest")
+	proto.RegisterType((*StatusResponse)(nil), "runtime.v1alpha2.StatusResponse")
+	proto.RegisterType((*ImageFsInfoRequest)(nil), "runtime.v1alpha2.ImageFsInfoRequest")
+	proto.RegisterType((*UInt64Value)(nil), "runtime.v1alpha2.UInt64Value")
+	proto.RegisterType((*FilesystemIdentifier)(nil), "runtime.v1alpha2.FilesystemIdentifier")
+	proto.RegisterType((*FilesystemUsage)(nil), "runtime.v1alpha2.FilesystemUsage")
+	proto.RegisterType((*ImageFsInfoResponse)(nil), "runtime.v1alpha2.ImageFsInfoResponse")
+	proto.RegisterType((*ContainerStatsRequest)(nil), "runtime.v1alpha2.ContainerStatsRequest")
+	proto.RegisterType((*ContainerStatsResponse)(nil), "runtime.v1alpha2.ContainerStatsResponse")
+	proto.RegisterType((*ListContainerStatsRequest)(nil), "runtime.v1alpha2.ListContainerStatsRequest")
+	proto.RegisterType((*ContainerStatsFilter)(nil), "runtime.v1alpha2.ContainerStatsFilter")
+	proto.RegisterType((*ListContainerStatsResponse)(nil), "runtime.v1alpha2.ListContainerStatsResponse")
+	proto.RegisterType((*ContainerAttributes)(nil), "runtime.v1alpha2.ContainerAttributes")
+	proto.RegisterType((*ContainerStats)(nil), "runtime.v1alpha2.ContainerStats")
+	proto.RegisterType((*CpuUsage)(nil), "runtime.v1alpha2.CpuUsage")
+	proto.RegisterType((*MemoryUsage)(nil), "runtime.v1alpha2.MemoryUsage")
+	proto.RegisterType((*ReopenContainerLogRequest)(nil), "runtime.v1alpha2.ReopenContainerLogRequest")
+	proto.RegisterType((*ReopenContainerLogResponse)(nil), "runtime.v1alpha2.ReopenContainerLogResponse")
+	proto.RegisterEnum("runtime.v1alpha2.Protocol", Protocol_name, Protocol_value)
+	proto.RegisterEnum("runtime.v1alpha2.MountPropagation", MountPropagation_name, MountPropagation_value)
+	proto.RegisterEnum("runtime.v1alpha2.NamespaceMode", NamespaceMode_name, NamespaceMode_value)
+	proto.RegisterEnum("runtime.v1alpha2.PodSandboxState", PodSandboxState_name, PodSandboxState_value)
+	proto.RegisterEnum("runtime.v1alpha2.ContainerState", ContainerState_name, ContainerState_value)
+	proto.RegisterEnum("runtime.v1alpha2.SecurityProfile_ProfileType", SecurityProfile_ProfileType_name, SecurityProfile_ProfileType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// Client API for RuntimeService service
+
+type RuntimeServiceClient interface {
+	// Version returns the runtime name, runtime version, and runtime API version.
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// RunPodSandbox creates and starts a pod-level sandbox. Runtimes must ensure
+	// the sandbox is in the ready state on success.
+	RunPodSandbox(ctx context.Context, in *RunPodSandboxRequest, opts ...grpc.CallOption) (*RunPodSandboxResponse, error)
+	// StopPodSandbox stops any running process that is part of the sandbox and
+	// reclaims network resources (e.g., IP addresses) allocated to the sandbox.
+	// If there are any running containers in the sandbox, they must be forcibly
+	// terminated.
+	// This call is idempotent, and must not return an error if all relevant
+	// resources have already been reclaimed. kubelet will call StopPodSandbox
+	// at least once before calling RemovePodSandbox. It will also attempt to
+	// reclaim resources eagerly, as soon as a sandbox is not needed. Hence,
+	// multiple StopPodSandbox calls are expected.
+	StopPodSandbox(ctx context.Context, in *StopPodSandboxRequest, opts ...grpc.CallOption) (*StopPodSandboxResponse, error)
+	// RemovePodSandbox removes the sandbox. If there are any running containers
+	// in the sandbox, they must be forcibly terminated and removed.
+	// This call is idempotent, and must not return an error if the sandbox has
+	// already been removed.
+	RemovePodSandbox(ctx context.Context, in *RemovePodSandboxRequest, opts ...grpc.CallOption) (*RemovePodSandboxResponse, error)
+	// PodSandboxStatus returns the status of the PodSandbox. If the PodSandbox is not
+	// present, returns an error.
+	PodSandboxStatus(ctx context.Context, in *PodSandboxStatusRequest, opts ...grpc.CallOption) (*PodSandboxStatusResponse, error)
+	// ListPodSandbox returns a list of PodSandboxes.
+	ListPodSandbox(ctx context.Context, in *ListPodSandboxRequest, opts ...grpc.CallOption) (*ListPodSandboxResponse, error)
+	// CreateContainer creates a new container in specified PodSandbox
+	CreateContainer(ctx context.Context, in *CreateContainerRequest, opts ...grpc.CallOption) (*CreateContainerResponse, error)
+	// StartContainer starts the container.
+	StartContainer(ctx context.Context, in *StartContainerRequest, opts ...grpc.CallOption) (*StartContainerResponse, error)
+	// StopContainer stops a running container with a grace period (i.e., timeout).
+	// This call is idempotent, and must not return an error if the container has
+	// already been stopped.
+	// TODO: what must the runtime do after the grace period is reached?
+	StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*StopContainerResponse, error)
+	// RemoveContainer removes the container. If the container is running, the
+	// container must be forcibly removed.
+	// This call is idempotent, and must not return an error if the container has
+	// already been removed.
+	RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc.CallOption) (*RemoveContainerResponse, error)
+	// ListContainers lists all containers by filters.
+	ListContainers(ctx context.Context, in *ListContainersRequest, opts ...grpc.CallOption) (*ListContainersResponse, error)
+	// ContainerStatus returns status of the container. If the container is not
+	// present, returns an error.
+	ContainerStatus(ctx context.Context, in *ContainerStatusRequest, opts ...grpc.CallOption) (*ContainerStatusResponse, error)
+	// UpdateContainerResources updates ContainerConfig of the container.
+	UpdateContainerResources(ctx context.Context, in *UpdateContainerResourcesRequest, opts ...grpc.CallOption) (*UpdateContainerResourcesResponse, error)
+	// ReopenContainerLog asks runtime to reopen the stdout/stderr log file
+	// for the container. This is often called after the log file has been
+	// rotated. If the container is not running, container runtime can choose
+	// to either create a new log file and return nil, or return an error.
+	// Once it returns error, new container log file MUST NOT be created.
+	ReopenContainerLog(ctx context.Context, in *ReopenContainerLogRequest, opts ...grpc.CallOption) (*ReopenContainerLogResponse, error)
+	// ExecSync runs a command in a container synchronously.
+	ExecSync(ctx context.Context, in *ExecSyncRequest, opts ...grpc.CallOption) (*ExecSyncResponse, error)
+	// Exec prepares a streaming endpoint to execute a command in the container.
+	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
+	// Attach prepares a streaming endpoint to attach to a running container.
+	Attach(ctx context.Context, in *AttachRequest, opts ...grpc.CallOption) (*AttachResponse, error)
+	// PortForward prepares a streaming endpoint to forward ports from a PodSandbox.
+	PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*PortForwardResponse, error)
+	// ContainerStats returns stats of the container. If the container does not
+	// exist, the call returns an error.
+	ContainerStats(ctx context.Context, in *ContainerStatsRequest, opts ...grpc.CallOption) (*ContainerStatsResponse, error)
+	// ListContainerStats returns stats of all running containers.
+	ListContainerStats(ctx context.Context, in *ListContainerStatsRequest, opts ...grpc.CallOption) (*ListContainerStatsResponse, error)
+	// UpdateRuntimeConfig updates the runtime configuration based on the given request.
+	UpdateRuntimeConfig(ctx context.Context, in *UpdateRuntimeConfigRequest, opts ...grpc.CallOption) (*UpdateRuntimeConfigResponse, error)
+	// Status returns the status of the runtime.
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+}
+
+type runtimeServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRuntimeServiceClient(cc *grpc.ClientConn) RuntimeServiceClient {
+	return &runtimeServiceClient{cc}
+}
+
+func (c *runtimeServiceClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/Version", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) RunPodSandbox(ctx context.Context, in *RunPodSandboxRequest, opts ...grpc.CallOption) (*RunPodSandboxResponse, error) {
+	out := new(RunPodSandboxResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/RunPodSandbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) StopPodSandbox(ctx context.Context, in *StopPodSandboxRequest, opts ...grpc.CallOption) (*StopPodSandboxResponse, error) {
+	out := new(StopPodSandboxResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/StopPodSandbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) RemovePodSandbox(ctx context.Context, in *RemovePodSandboxRequest, opts ...grpc.CallOption) (*RemovePodSandboxResponse, error) {
+	out := new(RemovePodSandboxResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/RemovePodSandbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) PodSandboxStatus(ctx context.Context, in *PodSandboxStatusRequest, opts ...grpc.CallOption) (*PodSandboxStatusResponse, error) {
+	out := new(PodSandboxStatusResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/PodSandboxStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListPodSandbox(ctx context.Context, in *ListPodSandboxRequest, opts ...grpc.CallOption) (*ListPodSandboxResponse, error) {
+	out := new(ListPodSandboxResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/ListPodSandbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) CreateContainer(ctx context.Context, in *CreateContainerRequest, opts ...grpc.CallOption) (*CreateContainerResponse, error) {
+	out := new(CreateContainerResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/CreateContainer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) StartContainer(ctx context.Context, in *StartContainerRequest, opts ...grpc.CallOption) (*StartContainerResponse, error) {
+	out := new(StartContainerResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/StartContainer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*StopContainerResponse, error) {
+	out := new(StopContainerResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/StopContainer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc.CallOption) (*RemoveContainerResponse, error) {
+	out := new(RemoveContainerResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/RemoveContainer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListContainers(ctx context.Context, in *ListContainersRequest, opts ...grpc.CallOption) (*ListContainersResponse, error) {
+	out := new(ListContainersResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/ListContainers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ContainerStatus(ctx context.Context, in *ContainerStatusRequest, opts ...grpc.CallOption) (*ContainerStatusResponse, error) {
+	out := new(ContainerStatusResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/ContainerStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) UpdateContainerResources(ctx context.Context, in *UpdateContainerResourcesRequest, opts ...grpc.CallOption) (*UpdateContainerResourcesResponse, error) {
+	out := new(UpdateContainerResourcesResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/UpdateContainerResources", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ReopenContainerLog(ctx context.Context, in *ReopenContainerLogRequest, opts ...grpc.CallOption) (*ReopenContainerLogResponse, error) {
+	out := new(ReopenContainerLogResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/ReopenContainerLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ExecSync(ctx context.Context, in *ExecSyncRequest, opts ...grpc.CallOption) (*ExecSyncResponse, error) {
+	out := new(ExecSyncResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/ExecSync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error) {
+	out := new(ExecResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/Exec", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) Attach(ctx context.Context, in *AttachRequest, opts ...grpc.CallOption) (*AttachResponse, error) {
+	out := new(AttachResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/Attach", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*PortForwardResponse, error) {
+	out := new(PortForwardResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/PortForward", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ContainerStats(ctx context.Context, in *ContainerStatsRequest, opts ...grpc.CallOption) (*ContainerStatsResponse, error) {
+	out := new(ContainerStatsResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/ContainerStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListContainerStats(ctx context.Context, in *ListContainerStatsRequest, opts ...grpc.CallOption) (*ListContainerStatsResponse, error) {
+	out := new(ListContainerStatsResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/ListContainerStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) UpdateRuntimeConfig(ctx context.Context, in *UpdateRuntimeConfigRequest, opts ...grpc.CallOption) (*UpdateRuntimeConfigResponse, error) {
+	out := new(UpdateRuntimeConfigResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/UpdateRuntimeConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/Status", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for RuntimeService service
+
+type RuntimeServiceServer interface {
+	// Version returns the runtime name, runtime version, and runtime API version.
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	// RunPodSandbox creates and starts a pod-level sandbox. Runtimes must ensure
+	// the sandbox is in the ready state on success.
+	RunPodSandbox(context.Context, *RunPodSandboxRequest) (*RunPodSandboxResponse, error)
+	// StopPodSandbox stops any running process that is part of the sandbox and
+	// reclaims network resources (e.g., IP addresses) allocated to the sandbox.
+	// If there are any running containers in the sandbox, they must be forcibly
+	// terminated.
+	// This call is idempotent, and must not return an error if all relevant
+	// resources have already been reclaimed. kubelet will call StopPodSandbox
+	// at least once before calling RemovePodSandbox. It will also attempt to
+	// reclaim resources eagerly, as soon as a sandbox is not needed. Hence,
+	// multiple StopPodSandbox calls are expected.
+	StopPodSandbox(context.Context, *StopPodSandboxRequest) (*StopPodSandboxResponse, error)
+	// RemovePodSandbox removes the sandbox. If there are any running containers
+	// in the sandbox, they must be forcibly terminated and removed.
+	// This call is idempotent, and must not return an error if the sandbox has
+	// already been removed.
+	RemovePodSandbox(context.Context, *RemovePodSandboxRequest) (*RemovePodSandboxResponse, error)
+	// PodSandboxStatus returns the status of the PodSandbox. If the PodSandbox is not
+	// present, returns an error.
+	PodSandboxStatus(context.Context, *PodSandboxStatusRequest) (*PodSandboxStatusResponse, error)
+	// ListPodSandbox returns a list of PodSandboxes.
+	ListPodSandbox(context.Context, *ListPodSandboxRequest) (*ListPodSandboxResponse, error)
+	// CreateContainer creates a new container in specified PodSandbox
+	CreateContainer(context.Context, *CreateContainerRequest) (*CreateContainerResponse, error)
+	// StartContainer starts the container.
+	StartContainer(context.Context, *StartContainerRequest) (*StartContainerResponse, error)
+	// StopContainer stops a running container with a grace period (i.e., timeout).
+	// This call is idempotent, and must not return an error if the container has
+	// already been stopped.
+	// TODO: what must the runtime do after the grace period is reached?
+	StopContainer(context.Context, *StopContainerRequest) (*StopContainerResponse, error)
+	// RemoveContainer removes the container. If the container is running, the
+	// container must be forcibly removed.
+	// This call is idempotent, and must not return an error if the container has
+	// already been removed.
+	RemoveContainer(context.Context, *RemoveContainerRequest) (*RemoveContainerResponse, error)
+	// ListContainers lists all containers by filters.
+	ListContainers(context.Context, *ListContainersRequest) (*ListContainersResponse, error)
+	// ContainerStatus returns status of the container. If the container is not
+	// present, returns an error.
+	ContainerStatus(context.Context, *ContainerStatusRequest) (*ContainerStatusResponse, error)
+	// UpdateContainerResources updates ContainerConfig of the container.
+	UpdateContainerResources(context.Context, *UpdateContainerResourcesRequest) (*UpdateContainerResourcesResponse, error)
+	// ReopenContainerLog asks runtime to reopen the stdout/stderr log file
+	// for the container. This is often called after the log file has been
+	// rotated. If the container is not running, container runtime can choose
+	// to either create a new log file and return nil, or return an error.
+	// Once it returns error, new container log file MUST NOT be created.
+	ReopenContainerLog(context.Context, *ReopenContainerLogRequest) (*ReopenContainerLogResponse, error)
+	// ExecSync runs a command in a container synchronously.
+	ExecSync(context.Context, *ExecSyncRequest) (*ExecSyncResponse, error)
+	// Exec prepares a streaming endpoint to execute a command in the container.
+	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
+	// Attach prepares a streaming endpoint to attach to a running container.
+	Attach(context.Context, *AttachRequest) (*AttachResponse, error)
+	// PortForward prepares a streaming endpoint to forward ports from a PodSandbox.
+	PortForward(context.Context, *PortForwardRequest) (*PortForwardResponse, error)
+	// ContainerStats returns stats of the container. If the container does not
+	// exist, the call returns an error.
+	ContainerStats(context.Context, *ContainerStatsRequest) (*ContainerStatsResponse, error)
+	// ListContainerStats returns stats of all running containers.
+	ListContainerStats(context.Context, *ListContainerStatsRequest) (*ListContainerStatsResponse, error)
+	// UpdateRuntimeConfig updates the runtime configuration based on the given request.
+	UpdateRuntimeConfig(context.Context, *UpdateRuntimeConfigRequest) (*UpdateRuntimeConfigResponse, error)
+	// Status returns the status of the runtime.
+	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+}
+
+func RegisterRuntimeServiceServer(s *grpc.Server, srv RuntimeServiceServer) {
+	s.RegisterService(&_RuntimeService_serviceDesc, srv)
+}
+
+func _RuntimeService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).Version(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_RunPodSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RunPodSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).RunPodSandbox(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_StopPodSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(StopPodSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).StopPodSandbox(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_RemovePodSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RemovePodSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).RemovePodSandbox(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_PodSandboxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(PodSandboxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).PodSandboxStatus(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_ListPodSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ListPodSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).ListPodSandbox(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_CreateContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(CreateContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).CreateContainer(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_StartContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(StartContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).StartContainer(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_StopContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(StopContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).StopContainer(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_RemoveContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RemoveContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).RemoveContainer(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_ListContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ListContainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).ListContainers(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_ContainerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ContainerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).ContainerStatus(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_UpdateContainerResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(UpdateContainerResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).UpdateContainerResources(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_ReopenContainerLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReopenContainerLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).ReopenContainerLog(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_ExecSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ExecSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).ExecSync(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).Exec(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_Attach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(AttachRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).Attach(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_PortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(PortForwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).PortForward(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_ContainerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ContainerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).ContainerStats(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_ListContainerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ListContainerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).ListContainerStats(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_UpdateRuntimeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(UpdateRuntimeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).UpdateRuntimeConfig(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RuntimeService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RuntimeServiceServer).Status(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _RuntimeService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "runtime.v1alpha2.RuntimeService",
+	HandlerType: (*RuntimeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Version",
+			Handler:    _RuntimeService_Version_Handler,
+		},
+		{
+			MethodName: "RunPodSandbox",
+			Handler:    _RuntimeService_RunPodSandbox_Handler,
+		},
+		{
+			MethodName: "StopPodSandbox",
+			Handler:    _RuntimeService_StopPodSandbox_Handler,
+		},
+		{
+			MethodName: "RemovePodSandbox",
+			Handler:    _RuntimeService_RemovePodSandbox_Handler,
+		},
+		{
+			MethodName: "PodSandboxStatus",
+			Handler:    _RuntimeService_PodSandboxStatus_Handler,
+		},
+		{
+			MethodName: "ListPodSandbox",
+			Handler:    _RuntimeService_ListPodSandbox_Handler,
+		},
+		{
+			MethodName: "CreateContainer",
+			Handler:    _RuntimeService_CreateContainer_Handler,
+		},
+		{
+			MethodName: "StartContainer",
+			Handler:    _RuntimeService_StartContainer_Handler,
+		},
+		{
+			MethodName: "StopContainer",
+			Handler:    _RuntimeService_StopContainer_Handler,
+		},
+		{
+			MethodName: "RemoveContainer",
+			Handler:    _RuntimeService_RemoveContainer_Handler,
+		},
+		{
+			MethodName: "ListContainers",
+			Handler:    _RuntimeService_ListContainers_Handler,
+		},
+		{
+			MethodName: "ContainerStatus",
+			Handler:    _RuntimeService_ContainerStatus_Handler,
+		},
+		{
+			MethodName: "UpdateContainerResources",
+			Handler:    _RuntimeService_UpdateContainerResources_Handler,
+		},
+		{
+			MethodName: "ReopenContainerLog",
+			Handler:    _RuntimeService_ReopenContainerLog_Handler,
+		},
+		{
+			MethodName: "ExecSync",
+			Handler:    _RuntimeService_ExecSync_Handler,
+		},
+		{
+			MethodName: "Exec",
+			Handler:    _RuntimeService_Exec_Handler,
+		},
+		{
+			MethodName: "Attach",
+			Handler:    _RuntimeService_Attach_Handler,
+		},
+		{
+			MethodName: "PortForward",
+			Handler:    _RuntimeService_PortForward_Handler,
+		},
+		{
+			MethodName: "ContainerStats",
+			Handler:    _RuntimeService_ContainerStats_Handler,
+		},
+		{
+			MethodName: "ListContainerStats",
+			Handler:    _RuntimeService_ListContainerStats_Handler,
+		},
+		{
+			MethodName: "UpdateRuntimeConfig",
+			Handler:    _RuntimeService_UpdateRuntimeConfig_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _RuntimeService_Status_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+// Client API for ImageService service
+
+type ImageServiceClient interface {
+	// ListImages lists existing images.
+	ListImages(ctx context.Context, in *ListImagesRequest, opts ...grpc.CallOption) (*ListImagesResponse, error)
+	// ImageStatus returns the status of the image. If the image is not
+	// present, returns a response with ImageStatusResponse.Image set to
+	// nil.
+	ImageStatus(ctx context.Context, in *ImageStatusRequest, opts ...grpc.CallOption) (*ImageStatusResponse, error)
+	// PullImage pulls an image with authentication config.
+	PullImage(ctx context.Context, in *PullImageRequest, opts ...grpc.CallOption) (*PullImageResponse, error)
+	// RemoveImage removes the image.
+	// This call is idempotent, and must not return an error if the image has
+	// already been removed.
+	RemoveImage(ctx context.Context, in *RemoveImageRequest, opts ...grpc.CallOption) (*RemoveImageResponse, error)
+	// ImageFSInfo returns information of the filesystem that is used to store images.
+	ImageFsInfo(ctx context.Context, in *ImageFsInfoRequest, opts ...grpc.CallOption) (*ImageFsInfoResponse, error)
+}
+
+type imageServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewImageServiceClient(cc *grpc.ClientConn) ImageServiceClient {
+	return &imageServiceClient{cc}
+}
+
+func (c *imageServiceClient) ListImages(ctx context.Context, in *ListImagesRequest, opts ...grpc.CallOption) (*ListImagesResponse, error) {
+	out := new(ListImagesResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.ImageService/ListImages", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ImageStatus(ctx context.Context, in *ImageStatusRequest, opts ...grpc.CallOption) (*ImageStatusResponse, error) {
+	out := new(ImageStatusResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.ImageService/ImageStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) PullImage(ctx context.Context, in *PullImageRequest, opts ...grpc.CallOption) (*PullImageResponse, error) {
+	out := new(PullImageResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.ImageService/PullImage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) RemoveImage(ctx context.Context, in *RemoveImageRequest, opts ...grpc.CallOption) (*RemoveImageResponse, error) {
+	out := new(RemoveImageResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.ImageService/RemoveImage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ImageFsInfo(ctx context.Context, in *ImageFsInfoRequest, opts ...grpc.CallOption) (*ImageFsInfoResponse, error) {
+	out := new(ImageFsInfoResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.ImageService/ImageFsInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ImageService service
+
+type ImageServiceServer interface {
+	// ListImages lists existing images.
+	ListImages(context.Context, *ListImagesRequest) (*ListImagesResponse, error)
+	// ImageStatus returns the status of the image. If the image is not
+	// present, returns a response with ImageStatusResponse.Image set to
+	// nil.
+	ImageStatus(context.Context, *ImageStatusRequest) (*ImageStatusResponse, error)
+	// PullImage pulls an image with authentication config.
+	PullImage(context.Context, *PullImageRequest) (*PullImageResponse, error)
+	// RemoveImage removes the image.
+	// This call is idempotent, and must not return an error if the image has
+	// already been removed.
+	RemoveImage(context.Context, *RemoveImageRequest) (*RemoveImageResponse, error)
+	// ImageFSInfo returns information of the filesystem that is used to store images.
+	ImageFsInfo(context.Context, *ImageFsInfoRequest) (*ImageFsInfoResponse, error)
+}
+
+func RegisterImageServiceServer(s *grpc.Server, srv ImageServiceServer) {
+	s.RegisterService(&_ImageService_serviceDesc, srv)
+}
+
+func _ImageService_ListImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ListImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ImageServiceServer).ListImages(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _ImageService_ImageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ImageStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ImageServiceServer).ImageStatus(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _ImageService_PullImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(PullImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ImageServiceServer).PullImage(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _ImageService_RemoveImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RemoveImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ImageServiceServer).RemoveImage(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _ImageService_ImageFsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ImageFsInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ImageServiceServer).ImageFsInfo(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _ImageService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "runtime.v1alpha2.ImageService",
+	HandlerType: (*ImageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListImages",
+			Handler:    _ImageService_ListImages_Handler,
+		},
+		{
+			MethodName: "ImageStatus",
+			Handler:    _ImageService_ImageStatus_Handler,
+		},
+		{
+			MethodName: "PullImage",
+			Handler:    _ImageService_PullImage_Handler,
+		},
+		{
+			MethodName: "RemoveImage",
+			Handler:    _ImageService_RemoveImage_Handler,
+		},
+		{
+			MethodName: "ImageFsInfo",
+			Handler:    _ImageService_ImageFsInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+var fileDescriptor0 = []byte{
+	// 4939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0xcd, 0x6f, 0x1b, 0x49,
+	0x76, 0x77, 0x93, 0x94, 0x44, 0x3e, 0x8a, 0x14, 0x55, 0x96, 0x2d, 0x9a, 0x1e, 0xef, 0xd8, 0xed,
+	0xf1, 0xf8, 0x63, 0x66, 0xe4, 0xb5, 0x66, 0xd6, 0x13, 0x7b, 0x66, 0x6c, 0xd3, 0x92, 0x6c, 0x33,
+	0x6b, 0x53, 0x4c, 0x53, 0x9a, 0x8f, 0x9d, 0x01, 0x7a, 0xdb, 0xec, 0x92, 0xd4, 0x6b, 0xb2, 0xbb,
+	0xa7, 0xab, 0x69, 0x5b, 0x1b, 0x20, 0x08, 0xb0, 0xc0, 0x1e, 0xf2, 0x27, 0xe4, 0xb8, 0x39, 0xe4,
+	0x90, 0x53, 0x0e, 0x39, 0xe5, 0xb4, 0x41, 0x4e, 0x01, 0x82, 0xdc, 0x36, 0x08, 0x72, 0xca, 0x21,
+	0xc0, 0x22, 0x40, 0x82, 0x20, 0xe7, 0x1c, 0x82, 0xfa, 0xea, 0xef, 0xe6, 0x87, 0xed, 0xd9, 0xd9,
+	0x9c, 0xc4, 0x7a, 0xfd, 0xde, 0xab, 0xd7, 0xaf, 0x5e, 0xbd, 0x7a, 0xf5, 0xab, 0x6a, 0x41, 0xc5,
+	0x70, 0xad, 0x0d, 0xd7, 0x73, 0x7c, 0x07, 0x35, 0xbc, 0xb1, 0xed, 0x5b, 0x23, 0xbc, 0xf1, 0xfc,
+	0x86, 0x31, 0x74, 0x8f, 0x8c, 0x4d, 0xf5, 0x1a, 0xd4, 0x3f, 0xc7, 0x1e, 0xb1, 0x1c, 0x5b, 0xc3,
+	0xdf, 0x8e, 0x31, 0xf1, 0x51, 0x13, 0x96, 0x9e, 0x73, 0x4a, 0x53, 0x39, 0xaf, 0x5c, 0xa9, 0x68,
+	0xb2, 0xa9, 0xfe, 0xa5, 0x02, 0x2b, 0x01, 0x33, 0x71, 0x1d, 0x9b, 0xe0, 0x7c, 0x6e, 0x74, 0x01,
+	0x96, 0x45, 0x6f, 0xba, 0x6d, 0x8c, 0x70, 0xb3, 0xc0, 0x1e, 0x57, 0x05, 0xad, 0x6b, 0x8c, 0x30,
+	0xba, 0x0c, 0x2b, 0x92, 0x45, 0x2a, 0x29, 0x32, 0xae, 0xba, 0x20, 0x8b, 0xde, 0xd0, 0x06, 0x9c,
+	0x94, 0x8c, 0x86, 0x6b, 0x05, 0xcc, 0x25, 0xc6, 0xbc, 0x2a, 0x1e, 0xb5, 0x5d, 0x4b, 0xf0, 0xab,
+	0x5f, 0x43, 0x65, 0xbb, 0xdb, 0xdf, 0x72, 0xec, 0x03, 0xeb, 0x90, 0x9a, 0x48, 0xb0, 0x47, 0x65,
+	0x9a, 0xca, 0xf9, 0x22, 0x35, 0x51, 0x34, 0x51, 0x0b, 0xca, 0x04, 0x1b, 0xde, 0xe0, 0x08, 0x93,
+	0x66, 0x81, 0x3d, 0x0a, 0xda, 0x54, 0xca, 0x71, 0x7d, 0xcb, 0xb1, 0x49, 0xb3, 0xc8, 0xa5, 0x44,
+	0x53, 0xfd, 0x95, 0x02, 0xd5, 0x9e, 0xe3, 0xf9, 0x4f, 0x0c, 0xd7, 0xb5, 0xec, 0x43, 0x74, 0x13,
+	0xca, 0xcc, 0xbb, 0x03, 0x67, 0xc8, 0x7c, 0x50, 0xdf, 0x6c, 0x6d, 0x24, 0xfd, 0xbc, 0xd1, 0x13,
+	0x1c, 0x5a, 0xc0, 0x8b, 0x2e, 0x41, 0x7d, 0xe0, 0xd8, 0xbe, 0x61, 0xd9, 0xd8, 0xd3, 0x5d, 0xc7,
+	0xf3, 0x99, 0x8b, 0x16, 0xb4, 0x5a, 0x40, 0xa5, 0xbd, 0xa0, 0xb3, 0x50, 0x39, 0x72, 0x88, 0xcf,
+	0x39, 0x8a, 0x8c, 0xa3, 0x4c, 0x09, 0xec, 0xe1, 0x3a, 0x2c, 0xb1, 0x87, 0x96, 0x2b, 0x9c, 0xb1,
+	0x48, 0x9b, 0x1d, 0x57, 0xfd, 0x8d, 0x02, 0x0b, 0x4f, 0x9c, 0xb1, 0xed, 0x27, 0xba, 0x31, 0xfc,
+	0x23, 0x31, 0x50, 0x91, 0x6e, 0x0c, 0xff, 0x28, 0xec, 0x86, 0x72, 0xf0, 0xb1, 0xe2, 0xdd, 0xd0,
+	0x87, 0x2d, 0x28, 0x7b, 0xd8, 0x30, 0x1d, 0x7b, 0x78, 0xcc, 0x4c, 0x28, 0x6b, 0x41, 0x9b, 0x0e,
+	0x22, 0xc1, 0x43, 0xcb, 0x1e, 0xbf, 0xd4, 0x3d, 0x3c, 0x34, 0x9e, 0xe2, 0x21, 0x33, 0xa5, 0xac,
+	0xd5, 0x05, 0x59, 0xe3, 0x54, 0xb4, 0x0d, 0x55, 0xd7, 0x73, 0x5c, 0xe3, 0xd0, 0xa0, 0x7e, 0x6c,
+	0x2e, 0x30, 0x57, 0xa9, 0x69, 0x57, 0x31, 0xb3, 0x7b, 0x21, 0xa7, 0x16, 0x15, 0x53, 0xff, 0x49,
+	0x81, 0x15, 0x1a, 0x3c, 0xc4, 0x35, 0x06, 0x78, 0x97, 0x0d, 0x09, 0xba, 0x05, 0x4b, 0x36, 0xf6,
+	0x5f, 0x38, 0xde, 0x33, 0x31, 0x00, 0x6f, 0xa7, 0xb5, 0x06, 0x32, 0x4f, 0x1c, 0x13, 0x6b, 0x92,
+	0x1f, 0xdd, 0x80, 0xa2, 0x6b, 0x99, 0xcd, 0xc2, 0x6c, 0x62, 0x94, 0x97, 0x8a, 0x58, 0xee, 0xa0,
+	0x59, 0x9c, 0x51, 0xc4, 0x72, 0x07, 0xd4, 0xb9, 0xbe, 0xe1, 0x1d, 0x62, 0x5f, 0xb7, 0x4c, 0x31,
+	0x50, 0x65, 0x4e, 0xe8, 0x98, 0xaa, 0x0a, 0xd0, 0xb1, 0xfd, 0x9b, 0x1f, 0x7d, 0x6e, 0x0c, 0xc7,
+	0x18, 0xad, 0xc1, 0xc2, 0x73, 0xfa, 0x83, 0xbd, 0x49, 0x51, 0xe3, 0x0d, 0xf5, 0xdf, 0x4a, 0x70,
+	0xf6, 0x31, 0x75, 0x66, 0xdf, 0xb0, 0xcd, 0xa7, 0xce, 0xcb, 0x3e, 0x1e, 0x8c, 0x3d, 0xcb, 0x3f,
+	0xde, 0x72, 0x6c, 0x1f, 0xbf, 0xf4, 0x51, 0x17, 0x56, 0x6d, 0xd9, 0xad, 0x2e, 0xe3, 0x96, 0x6a,
+	0xa8, 0x6e, 0x5e, 0x98, 0x60, 0x21, 0xf7, 0x9f, 0xd6, 0xb0, 0xe3, 0x04, 0x82, 0x1e, 0x85, 0x83,
+	0x2a, 0xb5, 0x15, 0x98, 0xb6, 0x8c, 0xf7, 0xed, 0xef, 0x30, 0xcb, 0x84, 0x2e, 0x39, 0xea, 0x52,
+	0xd3, 0xa7, 0x40, 0xa7, 0xbc, 0x6e, 0x10, 0x7d, 0x4c, 0xb0, 0xc7, 0xbc, 0x56, 0xdd, 0x7c, 0x2b,
+	0xad, 0x25, 0x74, 0x81, 0x56, 0xf1, 0xc6, 0x76, 0x9b, 0xec, 0x13, 0xec, 0xa1, 0x3b, 0xb0, 0x2c,
+	0xa4, 0x0f, 0x3d, 0x67, 0xec, 0x36, 0xcb, 0x33, 0x88, 0x03, 0x13, 0x7f, 0x48, 0xf9, 0x59, 0x86,
+	0x11, 0x81, 0xaa, 0x7b, 0x8e, 0xe3, 0x1f, 0x10, 0x19, 0x9c, 0x92, 0xac, 0x31, 0x2a, 0xba, 0x0e,
+	0x27, 0xc9, 0xd8, 0x75, 0x87, 0x78, 0x84, 0x6d, 0xdf, 0x18, 0xf2, 0xee, 0x48, 0x73, 0xe1, 0x7c,
+	0xf1, 0x4a, 0x51, 0x43, 0xd1, 0x47, 0x4c, 0x31, 0x41, 0x3f, 0x00, 0x70, 0x3d, 0xeb, 0xb9, 0x35,
+	0xc4, 0x87, 0xd8, 0x6c, 0x2e, 0x32, 0xa5, 0x11, 0x0a, 0xfa, 0x84, 0x66, 0x9d, 0xc1, 0xc0, 0x19,
+	0xb9, 0xcd, 0x4a, 0xde, 0x38, 0xc8, 0x51, 0xec, 0x79, 0xce, 0x81, 0x35, 0xc4, 0x9a, 0x94, 0x40,
+	0x9f, 0x41, 0xd9, 0x70, 0x5d, 0xc3, 0x1b, 0x39, 0x5e, 0x13, 0x66, 0x95, 0x0e, 0x44, 0xd0, 0x47,
+	0xb0, 0x26, 0x34, 0xe9, 0x2e, 0x7f, 0xc8, 0xa7, 0xf5, 0x12, 0x8d, 0xbc, 0xfb, 0x85, 0xa6, 0xa2,
+	0x21, 0xf1, 0x5c, 0xc8, 0xd2, 0x49, 0xae, 0xfe, 0x83, 0x02, 0x2b, 0x09, 0x9d, 0xa8, 0x07, 0xcb,
+	0x52, 0x83, 0x7f, 0xec, 0x62, 0x31, 0xbd, 0x3e, 0x98, 0x6a, 0xcc, 0x86, 0xf8, 0xbb, 0x77, 0xec,
+	0x62, 0x36, 0x7f, 0x65, 0x03, 0x5d, 0x84, 0xda, 0xd0, 0x19, 0x18, 0x43, 0x96, 0x6c, 0x3c, 0x7c,
+	0x20, 0x72, 0xcd, 0x72, 0x40, 0xd4, 0xf0, 0x81, 0x7a, 0x0f, 0xaa, 0x11, 0x05, 0x08, 0x41, 0x5d,
+	0xe3, 0x1d, 0x6e, 0xe3, 0x03, 0x63, 0x3c, 0xf4, 0x1b, 0x27, 0x50, 0x1d, 0x60, 0xdf, 0x1e, 0xd0,
+	0x0c, 0x6f, 0x63, 0xb3, 0xa1, 0xa0, 0x1a, 0x54, 0x1e, 0x4b, 0x15, 0x8d, 0x82, 0xfa, 0xab, 0x02,
+	0x9c, 0x62, 0x61, 0xd9, 0x73, 0x4c, 0x31, 0x67, 0xc4, 0x72, 0x70, 0x11, 0x6a, 0x03, 0x36, 0xba,
+	0xba, 0x6b, 0x78, 0xd8, 0xf6, 0x45, 0x3a, 0x5c, 0xe6, 0xc4, 0x1e, 0xa3, 0xa1, 0x2f, 0xa1, 0x41,
+	0xc4, 0x1b, 0xe9, 0x03, 0x3e, 0xc7, 0xc4, 0x04, 0xc8, 0x78, 0xf7, 0x09, 0x13, 0x53, 0x5b, 0x21,
+	0xa9, 0x99, 0xba, 0x44, 0x8e, 0xc9, 0xc0, 0x1f, 0xf2, 0x75, 0xa5, 0xba, 0xf9, 0x51, 0x8e, 0xc2,
+	0xa4, 0xe1, 0x1b, 0x7d, 0x2e, 0xb6, 0x63, 0xfb, 0xde, 0xb1, 0x26, 0x95, 0xb4, 0x6e, 0xc3, 0x72,
+	0xf4, 0x01, 0x6a, 0x40, 0xf1, 0x19, 0x3e, 0x16, 0x2f, 0x45, 0x7f, 0x86, 0x19, 0x85, 0x7b, 0x9a,
+	0x37, 0x6e, 0x17, 0xfe, 0x40, 0x51, 0x3d, 0x40, 0x61, 0x2f, 0x4f, 0xb0, 0x6f, 0x98, 0x86, 0x6f,
+	0x20, 0x04, 0x25, 0xb6, 0x60, 0x73, 0x15, 0xec, 0x37, 0xd5, 0x3a, 0x16, 0x69, 0xb2, 0xa2, 0xd1,
+	0x9f, 0xe8, 0x2d, 0xa8, 0x04, 0x59, 0x43, 0xac, 0xda, 0x21, 0x81, 0xae, 0x9e, 0x86, 0xef, 0xe3,
+	0x91, 0xeb, 0xb3, 0xf9, 0x56, 0xd3, 0x64, 0x53, 0xfd, 0xaf, 0x12, 0x34, 0x52, 0x63, 0x72, 0x0f,
+	0xca, 0x23, 0xd1, 0xbd, 0xc8, 0x5a, 0xef, 0x64, 0x2c, 0xa1, 0x29, 0x53, 0xb5, 0x40, 0x8a, 0xae,
+	0x50, 0x74, 0xe4, 0x23, 0x95, 0x46, 0xd0, 0xe6, 0x21, 0x77, 0xa8, 0x9b, 0x96, 0x87, 0x07, 0xbe,
+	0xe3, 0x1d, 0x0b, 0x73, 0x97, 0x87, 0xce, 0xe1, 0xb6, 0xa4, 0xa1, 0xdb, 0x00, 0xa6, 0x4d, 0x74,
+	0x16, 0x51, 0x87, 0xcc, 0xe8, 0xea, 0xe6, 0xd9, 0xb4, 0x11, 0x41, 0x59, 0xa1, 0x55, 0x4c, 0x9b,
+	0x08, 0xf3, 0xef, 0x43, 0x8d, 0xae, 0xce, 0xfa, 0x88, 0x57, 0x04, 0x3c, 0x6d, 0x54, 0x37, 0xcf,
+	0x65, 0xbd, 0x43, 0x50, 0x37, 0x68, 0xcb, 0x6e, 0xd8, 0x20, 0xe8, 0x01, 0x2c, 0xb2, 0x65, 0x92,
+	0x34, 0x17, 0x99, 0xf0, 0xc6, 0x24, 0x07, 0x88, 0x88, 0x78, 0xcc, 0x04, 0x78, 0x40, 0x08, 0x69,
+	0xb4, 0x0f, 0x55, 0xc3, 0xb6, 0x1d, 0xdf, 0xe0, 0x59, 0x7b, 0x89, 0x29, 0xfb, 0x70, 0x06, 0x65,
+	0xed, 0x50, 0x8a, 0x6b, 0x8c, 0xea, 0x41, 0x9f, 0xc1, 0x02, 0x4b, 0xeb, 0x22, 0x03, 0x5f, 0x9e,
+	0x31, 0x68, 0x35, 0x2e, 0xd5, 0xba, 0x05, 0xd5, 0x88, 0xb1, 0xf3, 0x04, 0x69, 0xeb, 0x0e, 0x34,
+	0x92, 0xa6, 0xcd, 0x15, 0xe4, 0x7f, 0x0c, 0x6b, 0xda, 0xd8, 0x0e, 0x0d, 0x93, 0x75, 0xee, 0x6d,
+	0x58, 0x14, 0x83, 0xcd, 0x23, 0x4e, 0x9d, 0xee, 0x23, 0x4d, 0x48, 0x44, 0x0b, 0xd7, 0x23, 0xc3,
+	0x36, 0x87, 0xd8, 0x6b, 0x16, 0x62, 0x85, 0xeb, 0x23, 0x4e, 0x55, 0x3f, 0x83, 0x53, 0x89, 0xce,
+	0x45, 0xdd, 0xfc, 0x0e, 0xd4, 0x5d, 0xc7, 0xd4, 0x09, 0x27, 0xd3, 0xb2, 0x40, 0xa4, 0x21, 0x37,
+	0xe0, 0xed, 0x98, 0x54, 0xbc, 0xef, 0x3b, 0x6e, 0xda, 0xf8, 0xd9, 0xc4, 0x9b, 0x70, 0x3a, 0x29,
+	0xce, 0xbb, 0x57, 0xef, 0xc2, 0xba, 0x86, 0x47, 0xce, 0x73, 0xfc, 0xaa, 0xaa, 0x5b, 0xd0, 0x4c,
+	0x2b, 0x10, 0xca, 0xbf, 0x82, 0xf5, 0x90, 0xda, 0xf7, 0x0d, 0x7f, 0x4c, 0xe6, 0x52, 0x2e, 0x36,
+	0x15, 0x4f, 0x1d, 0xc2, 0x87, 0xb3, 0xac, 0xc9, 0xa6, 0xba, 0x0e, 0x0b, 0x3d, 0xc7, 0xec, 0xf4,
+	0x50, 0x1d, 0x0a, 0x96, 0x2b, 0x84, 0x0b, 0x96, 0xab, 0x5a, 0xd1, 0x3e, 0xbb, 0xbc, 0xb8, 0xe3,
+	0x5d, 0x27, 0x59, 0xd1, 0x1d, 0xa8, 0x1b, 0xa6, 0x69, 0xd1, 0x70, 0x32, 0x86, 0xba, 0xe5, 0xf2,
+	0xda, 0xbf, 0xba, 0xb9, 0x9e, 0x19, 0x00, 0x9d, 0x9e, 0x56, 0x0b, 0xd9, 0x3b, 0x2e, 0x51, 0x1f,
+	0x41, 0x25, 0x28, 0xa0, 0xe8, 0x32, 0x1f, 0x2f, 0x90, 0x66, 0x28, 0xb7, 0x82, 0x9d, 0xc4, 0x5e,
+	0x6a, 0x8d, 0x12, 0x26, 0x7f, 0x02, 0x10, 0xe4, 0x52, 0x59, 0xc7, 0x9d, 0x9d, 0xa0, 0x58, 0x8b,
+	0xb0, 0xab, 0xbf, 0x58, 0x80, 0x46, 0x4a, 0x23, 0x75, 0x82, 0x19, 0x38, 0xc1, 0x8c, 0x65, 0xdc,
+	0xc2, 0x2b, 0x65, 0xdc, 0x8f, 0x61, 0x81, 0xf8, 0x86, 0x8f, 0x45, 0x21, 0x7c, 0x61, 0x92, 0x38,
+	0x35, 0x02, 0x6b, 0x9c, 0x1f, 0x9d, 0x03, 0x18, 0x78, 0xd8, 0xf0, 0xb1, 0xa9, 0x1b, 0x7c, 0x79,
+	0x28, 0x6a, 0x15, 0x41, 0x69, 0xfb, 0x68, 0x2b, 0x2c, 0xe6, 0x17, 0x98, 0x61, 0x57, 0x27, 0x69,
+	0x8e, 0x0d, 0x75, 0x58, 0xd6, 0x07, 0xe9, 0x6a, 0x71, 0xc6, 0x74, 0x25, 0x14, 0x70, 0xa9, 0x48,
+	0x32, 0x5e, 0x9a, 0x9e, 0x8c, 0xb9, 0xe8, 0x2c, 0xc9, 0xb8, 0x3c, 0x3d, 0x19, 0x0b, 0x65, 0x93,
+	0x93, 0x71, 0x46, 0xfa, 0xa9, 0x64, 0xa5, 0x9f, 0xef, 0x33, 0xed, 0xfe, 0x8b, 0x02, 0xcd, 0x74,
+	0x16, 0x10, 0xd9, 0xef, 0x36, 0x2c, 0x12, 0x46, 0x99, 0x25, 0xf7, 0x0a, 0x59, 0x21, 0x81, 0x1e,
+	0x41, 0xc9, 0xb2, 0x0f, 0x9c, 0x66, 0x21, 0xaf, 0x7a, 0xca, 0xeb, 0x75, 0xa3, 0x63, 0x1f, 0x38,
+	0xdc, 0x9b, 0x4c, 0x43, 0xeb, 0x63, 0xa8, 0x04, 0xa4, 0xb9, 0xde, 0x6d, 0x17, 0xd6, 0x12, 0xb1,
+	0xcd, 0xf7, 0x6e, 0xc1, 0x94, 0x50, 0xe6, 0x9b, 0x12, 0xea, 0x9f, 0x16, 0xa2, 0x53, 0xf6, 0x81,
+	0x35, 0xf4, 0xb1, 0x97, 0x9a, 0xb2, 0x9f, 0x4a, 0xed, 0x7c, 0xbe, 0xbe, 0x3b, 0x55, 0x3b, 0xdf,
+	0x0e, 0x89, 0x59, 0xf7, 0x0d, 0xd4, 0x59, 0x50, 0xea, 0x04, 0x0f, 0x59, 0xc9, 0x23, 0xca, 0xcf,
+	0x1f, 0x4d, 0x52, 0xc3, 0x2d, 0xe1, 0xa1, 0xdd, 0x17, 0x72, 0xdc, 0x83, 0xb5, 0x61, 0x94, 0xd6,
+	0xba, 0x07, 0x28, 0xcd, 0x34, 0x97, 0x4f, 0xfb, 0x34, 0x17, 0x12, 0x3f, 0xec, 0x3b, 0xb2, 0x4e,
+	0x1f, 0x30, 0x33, 0x66, 0x89, 0x15, 0x6e, 0xb0, 0x26, 0x24, 0xd4, 0xff, 0x2c, 0x02, 0x84, 0x0f,
+	0xff, 0x1f, 0x25, 0xc1, 0x7b, 0x41, 0x02, 0xe2, 0xa5, 0xe4, 0x95, 0x49, 0x8a, 0x33, 0x53, 0xcf,
+	0x6e, 0x3c, 0xf5, 0xf0, 0xa2, 0xf2, 0x83, 0x89, 0x6a, 0xe6, 0x4e, 0x3a, 0x4b, 0xbf, 0x6f, 0x49,
+	0xe7, 0x31, 0x9c, 0x4e, 0x06, 0x91, 0xc8, 0x38, 0x9b, 0xb0, 0x60, 0xf9, 0x78, 0xc4, 0x21, 0xc0,
+	0x4c, 0x04, 0x21, 0x22, 0xc4, 0x59, 0xd5, 0xbf, 0x56, 0xa0, 0xd2, 0x19, 0x19, 0x87, 0xb8, 0xef,
+	0xe2, 0x01, 0xed, 0xd5, 0xa2, 0x0d, 0x61, 0x09, 0x6f, 0xa0, 0x6e, 0xdc, 0xcd, 0x3c, 0x29, 0xbd,
+	0x9f, 0xd6, 0x1e, 0xe8, 0x99, 0xec, 0xe5, 0xd7, 0xf6, 0xc0, 0x26, 0x94, 0x7f, 0x8c, 0x8f, 0x79,
+	0x3a, 0x9a, 0x51, 0x4e, 0xfd, 0xe7, 0x02, 0xac, 0xb3, 0xe5, 0x70, 0x4b, 0x22, 0x82, 0x1a, 0x26,
+	0xce, 0xd8, 0x1b, 0x60, 0xc2, 0xe2, 0xd4, 0x1d, 0xeb, 0x2e, 0xf6, 0x2c, 0xc7, 0x14, 0x98, 0x54,
+	0x65, 0xe0, 0x8e, 0x7b, 0x8c, 0x40, 0x81, 0x2d, 0xfa, 0xf8, 0xdb, 0xb1, 0x23, 0xa6, 0x50, 0x51,
+	0x2b, 0x0f, 0xdc, 0xf1, 0x1f, 0xd1, 0xb6, 0x94, 0x25, 0x47, 0x86, 0x87, 0x49, 0xb3, 0x18, 0xc8,
+	0xf6, 0x19, 0x01, 0xdd, 0x80, 0x53, 0x23, 0x3c, 0x72, 0xbc, 0x63, 0x7d, 0x68, 0x8d, 0x2c, 0x5f,
+	0xb7, 0x6c, 0xfd, 0xe9, 0xb1, 0x8f, 0x89, 0x98, 0x0d, 0x88, 0x3f, 0x7c, 0x4c, 0x9f, 0x75, 0xec,
+	0xfb, 0xf4, 0x09, 0x52, 0xa1, 0xe6, 0x38, 0x23, 0x9d, 0x0c, 0x1c, 0x0f, 0xeb, 0x86, 0xf9, 0x33,
+	0x56, 0x21, 0x14, 0xb5, 0xaa, 0xe3, 0x8c, 0xfa, 0x94, 0xd6, 0x36, 0x7f, 0x86, 0xde, 0x86, 0xea,
+	0xc0, 0x1d, 0x13, 0xec, 0xeb, 0xf4, 0x0f, 0x2b, 0x00, 0x2a, 0x1a, 0x70, 0xd2, 0x96, 0x3b, 0x26,
+	0x11, 0x86, 0x11, 0x0d, 0x88, 0xa5, 0x28, 0xc3, 0x13, 0x3c, 0x62, 0xe0, 0xd7, 0xd1, 0xf8, 0x10,
+	0xbb, 0xc6, 0x21, 0xe6, 0xa6, 0xc9, 0x95, 0x3b, 0x03, 0xfc, 0x7a, 0x24, 0x18, 0x99, 0x99, 0x5a,
+	0xfd, 0x28, 0xda, 0x24, 0xea, 0x7d, 0xa8, 0xc5, 0x18, 0xa8, 0xbf, 0x98, 0x5a, 0x62, 0xfd, 0x5c,
+	0x06, 0x52, 0x99, 0x12, 0xfa, 0xd6, 0xcf, 0x19, 0xf4, 0xc7, 0xba, 0x63, 0x8e, 0x2c, 0x69, 0xbc,
+	0xa1, 0x1a, 0x50, 0x8b, 0x21, 0x6c, 0x74, 0x7f, 0xce, 0xa0, 0x34, 0xb1, 0x3f, 0xa7, 0xbf, 0x29,
+	0xcd, 0x73, 0x86, 0x72, 0x5c, 0xd9, 0x6f, 0x4a, 0x63, 0x98, 0x0d, 0xdf, 0xed, 0xb2, 0xdf, 0xac,
+	0x0b, 0xfc, 0x5c, 0x40, 0xb4, 0x15, 0x8d, 0x37, 0x54, 0x13, 0x60, 0xcb, 0x70, 0x8d, 0xa7, 0xd6,
+	0xd0, 0xf2, 0x8f, 0xd1, 0x55, 0x68, 0x18, 0xa6, 0xa9, 0x0f, 0x24, 0xc5, 0xc2, 0x12, 0x38, 0x5f,
+	0x31, 0x4c, 0x73, 0x2b, 0x42, 0x46, 0xef, 0xc1, 0xaa, 0xe9, 0x39, 0x6e, 0x9c, 0x97, 0x23, 0xe9,
+	0x0d, 0xfa, 0x20, 0xca, 0xac, 0xfe, 0x76, 0x11, 0xce, 0xc5, 0xc3, 0x2c, 0x89, 0x62, 0xde, 0x83,
+	0xe5, 0x44, 0xaf, 0x39, 0x68, 0x5f, 0x68, 0xad, 0x16, 0x93, 0x48, 0xa0, 0x72, 0x85, 0x14, 0x2a,
+	0x97, 0x89, 0x93, 0x16, 0xdf, 0x28, 0x4e, 0x5a, 0x7a, 0x23, 0x38, 0xe9, 0xc2, 0xeb, 0xe1, 0xa4,
+	0xcb, 0x73, 0xe2, 0xa4, 0xef, 0xc2, 0x8a, 0x90, 0xa7, 0xbd, 0x33, 0x14, 0x85, 0x4f, 0x9c, 0x5a,
+	0xd0, 0x87, 0x2d, 0x4f, 0x6c, 0x12, 0x78, 0xea, 0xd2, 0x3c, 0x78, 0x6a, 0x39, 0x17, 0x4f, 0x3d,
+	0x0f, 0xcb, 0xb6, 0xa3, 0xdb, 0xf8, 0x85, 0x4e, 0x87, 0x8b, 0x34, 0xab, 0x7c, 0xec, 0x6c, 0xa7,
+	0x8b, 0x5f, 0xf4, 0x28, 0x85, 0x1e, 0x28, 0x8d, 0x0c, 0xf2, 0x0c, 0x9b, 0x0c, 0xcc, 0x24, 0xcd,
+	0x1a, 0x8b, 0xb3, 0x2a, 0xa7, 0x51, 0x04, 0x93, 0xd0, 0xb3, 0x8e, 0xc0, 0x3c, 0xce, 0x54, 0x67,
+	0x4c, 0x35, 0x49, 0xe5, 0x6c, 0x11, 0x6c, 0x76, 0xe5, 0xb5, 0xb0, 0xd9, 0xc6, 0xfc, 0xd8, 0xec,
+	0x07, 0xd0, 0x90, 0xbf, 0x25, 0x38, 0xdb, 0xac, 0x04, 0xb8, 0xec, 0x8a, 0x7c, 0x26, 0x04, 0x73,
+	0xa1, 0x5c, 0x98, 0x08, 0xe5, 0xfe, 0xad, 0x02, 0x6b, 0xf1, 0xa9, 0x26, 0x90, 0xaa, 0x87, 0x50,
+	0xf1, 0x64, 0x6e, 0x6f, 0x2a, 0x79, 0xdb, 0xab, 0x9c, 0xc5, 0x40, 0x0b, 0x65, 0xd1, 0x4f, 0x72,
+	0x01, 0xd2, 0xeb, 0xd3, 0xf4, 0x4d, 0x83, 0x48, 0x55, 0x0f, 0xde, 0xfe, 0xc2, 0xb2, 0x4d, 0xe7,
+	0x05, 0xc9, 0xcd, 0x14, 0x19, 0xf1, 0xaa, 0xe4, 0xc4, 0xeb, 0xc0, 0xc3, 0x26, 0xb6, 0x7d, 0xcb,
+	0x18, 0xea, 0xc4, 0xc5, 0x03, 0x09, 0xd4, 0x84, 0x64, 0xba, 0x2a, 0xab, 0xbf, 0x56, 0xe0, 0x74,
+	0xb2, 0x53, 0xe1, 0xb3, 0x4e, 0xda, 0x67, 0xef, 0xa5, 0xdf, 0x31, 0x29, 0x9c, 0xe9, 0xb5, 0x6f,
+	0x72, 0xbd, 0x76, 0x63, 0xba, 0xc6, 0xa9, 0x7e, 0xfb, 0x2b, 0x05, 0xce, 0xe4, 0x9a, 0x91, 0x58,
+	0x8d, 0x95, 0xe4, 0x6a, 0x2c, 0x56, 0xf2, 0x81, 0x33, 0xb6, 0xfd, 0xc8, 0x4a, 0xbe, 0x45, 0xdb,
+	0x62, 0xc9, 0xd4, 0x47, 0xc6, 0x4b, 0x6b, 0x34, 0x1e, 0x89, 0xa5, 0x9c, 0xaa, 0x7b, 0xc2, 0x29,
+	0xaf, 0xb0, 0x96, 0xab, 0x6d, 0x58, 0x0d, 0xac, 0x9c, 0x88, 0x3d, 0x47, 0xb0, 0xe4, 0x42, 0x1c,
+	0x4b, 0xb6, 0x61, 0x71, 0x1b, 0x3f, 0xb7, 0x06, 0xf8, 0x8d, 0x1c, 0x72, 0x9e, 0x87, 0xaa, 0x8b,
+	0xbd, 0x91, 0x45, 0x48, 0xb0, 0x2a, 0x54, 0xb4, 0x28, 0x49, 0xfd, 0xf7, 0x45, 0x58, 0x49, 0x46,
+	0xc7, 0xdd, 0x14, 0x74, 0x7d, 0x31, 0x63, 0xbd, 0x4a, 0xbe, 0x68, 0x64, 0x0b, 0x71, 0x43, 0xd6,
+	0x95, 0x85, 0x3c, 0x98, 0x27, 0xa8, 0x1d, 0x65, 0xd1, 0xd9, 0x84, 0xa5, 0x81, 0x33, 0x1a, 0x19,
+	0xb6, 0x29, 0xcf, 0xa6, 0x45, 0x93, 0xfa, 0xcf, 0xf0, 0x0e, 0xa9, 0xdb, 0x29, 0x99, 0xfd, 0xa6,
+	0x83, 0x47, 0x31, 0x11, 0xcb, 0x66, 0x10, 0x38, 0x5b, 0x59, 0x2a, 0x1a, 0x08, 0xd2, 0xb6, 0xe5,
+	0xa1, 0x0d, 0x28, 0x61, 0xfb, 0xb9, 0xdc, 0x23, 0x64, 0x1c, 0x5e, 0xcb, 0x8a, 0x52, 0x63, 0x7c,
+	0xe8, 0x3a, 0x2c, 0x8e, 0x68, 0x58, 0x48, 0x74, 0x64, 0x3d, 0xe7, 0x0c, 0x57, 0x13, 0x6c, 0x68,
+	0x13, 0x96, 0x4c, 0x36, 0x4e, 0xb2, 0x90, 0x6a, 0x66, 0x00, 0xeb, 0x8c, 0x41, 0x93, 0x8c, 0x68,
+	0x27, 0xd8, 0x01, 0x55, 0xf2, 0xb6, 0x2e, 0x89, 0xa1, 0xc8, 0xdc, 0x06, 0xed, 0xc5, 0xeb, 0x73,
+	0x60, 0xba, 0x36, 0xa7, 0xeb, 0x9a, 0xbc, 0x17, 0x3a, 0x03, 0x65, 0x7a, 0xa2, 0xc0, 0xc2, 0xa8,
+	0xca, 0xaf, 0x3d, 0x0c, 0x9d, 0x43, 0x16, 0x45, 0x6b, 0x74, 0x47, 0x68, 0x5a, 0x36, 0x5b, 0x82,
+	0xcb, 0x1a, 0x6f, 0xd0, 0xc9, 0xc7, 0x7e, 0xe8, 0x8e, 0x3d, 0xc0, 0xcd, 0x1a, 0x7b, 0x54, 0x61,
+	0x94, 0x5d, 0x7b, 0xc0, 0x2a, 0x75, 0xdf, 0x3f, 0x6e, 0xd6, 0x19, 0x9d, 0xfe, 0xa4, 0x9b, 0x7d,
+	0x0e, 0x60, 0xad, 0xe4, 0x6d, 0xf6, 0xb3, 0xf2, 0xbb, 0xc4, 0xaf, 0xee, 0xc3, 0xd2, 0x0b, 0x9e,
+	0x08, 0xc4, 0x12, 0x75, 0x65, 0x7a, 0x7a, 0x11, 0x1a, 0xa4, 0xe0, 0xf7, 0xb9, 0x8d, 0xfb, 0x7b,
+	0x05, 0x4e, 0x6f, 0xb1, 0xbd, 0x70, 0x24, 0x8f, 0xcd, 0x03, 0x20, 0xdf, 0x0a, 0xb0, 0xfd, 0x5c,
+	0x50, 0x36, 0xf9, 0xde, 0x42, 0x00, 0x75, 0xa0, 0x2e, 0x95, 0x0b, 0x15, 0xc5, 0x99, 0x8f, 0x07,
+	0x6a, 0x24, 0xda, 0x54, 0x3f, 0x85, 0xf5, 0xd4, 0x5b, 0x88, 0xed, 0xe8, 0x05, 0x58, 0x0e, 0xf3,
+	0x55, 0xf0, 0x12, 0xd5, 0x80, 0xd6, 0x31, 0xd5, 0xdb, 0x14, 0xfb, 0x37, 0x3c, 0x3f, 0xe5, 0x82,
+	0x19, 0x64, 0x19, 0xf0, 0x1f, 0x97, 0x15, 0xd8, 0x7c, 0x1f, 0xd6, 0xe8, 0x91, 0xc0, 0x2b, 0x28,
+	0xa5, 0x59, 0x87, 0xbe, 0xbf, 0x33, 0x96, 0xeb, 0x83, 0x6c, 0xaa, 0xeb, 0x70, 0x2a, 0xa1, 0x54,
+	0xf4, 0xf6, 0x09, 0x9c, 0xe6, 0xa7, 0x04, 0xaf, 0xf2, 0x12, 0x67, 0x60, 0x3d, 0x25, 0x2c, 0xf4,
+	0x3e, 0x81, 0x93, 0xe1, 0xb2, 0x18, 0xe2, 0x6f, 0x37, 0xe3, 0xf8, 0xdb, 0xf9, 0x09, 0xa3, 0x1e,
+	0x83, 0xdf, 0xfe, 0xa2, 0x10, 0xc9, 0xeb, 0x39, 0xe8, 0xdb, 0x27, 0x71, 0xf4, 0xed, 0xd2, 0x34,
+	0xdd, 0x31, 0xf0, 0x2d, 0x1d, 0xb5, 0xc5, 0x8c, 0xa8, 0xfd, 0x3a, 0x05, 0xd1, 0x95, 0xf2, 0x30,
+	0xce, 0x84, 0xb5, 0xbf, 0x13, 0x84, 0x4e, 0xe3, 0x08, 0x5d, 0xd0, 0x75, 0x70, 0xa8, 0x73, 0x2b,
+	0x81, 0xd0, 0x5d, 0x98, 0x6a, 0x6f, 0x00, 0xd0, 0xfd, 0x4d, 0x09, 0x2a, 0xc1, 0xb3, 0x94, 0xcf,
+	0xd3, 0x6e, 0x2b, 0x64, 0xb8, 0x2d, 0xba, 0x02, 0x17, 0x5f, 0x6b, 0x05, 0x2e, 0xcd, 0xbc, 0x02,
+	0x9f, 0x85, 0x0a, 0xfb, 0xc1, 0x6e, 0x30, 0xf0, 0x15, 0xb5, 0xcc, 0x08, 0x1a, 0x3e, 0x08, 0xc3,
+	0x70, 0x71, 0xae, 0x30, 0x4c, 0x60, 0x82, 0x4b, 0x49, 0x4c, 0xf0, 0x6e, 0xb0, 0x22, 0xf2, 0x45,
+	0xf4, 0xf2, 0x04, 0xbd, 0x99, 0x6b, 0x61, 0x02, 0xab, 0xaa, 0xe4, 0x61, 0x55, 0xa1, 0x96, 0xc9,
+	0x58, 0xd5, 0xf7, 0xb8, 0x42, 0xec, 0x73, 0xa0, 0x2f, 0x1a, 0x8b, 0x22, 0xb3, 0x7e, 0x02, 0x10,
+	0x24, 0x11, 0x89, 0xf6, 0x9d, 0x9d, 0xf0, 0x8e, 0x5a, 0x84, 0x9d, 0xaa, 0x8d, 0x0d, 0xcd, 0x98,
+	0xcc, 0x9e, 0xaf, 0x26, 0x9c, 0x5a, 0xfe, 0xef, 0x02, 0xac, 0x24, 0xf4, 0xa6, 0x62, 0xfd, 0x6e,
+	0x0a, 0x8b, 0x9e, 0x33, 0x8a, 0x6f, 0xc6, 0xa1, 0xe8, 0x57, 0x8c, 0xba, 0x14, 0x12, 0xcd, 0x2a,
+	0x17, 0xc3, 0x13, 0x8f, 0x39, 0xde, 0x56, 0x11, 0x94, 0x36, 0xdb, 0x19, 0x1c, 0x58, 0xb6, 0x45,
+	0x8e, 0xf8, 0xf3, 0x45, 0xf6, 0x1c, 0x24, 0xa9, 0xcd, 0x10, 0x2f, 0xfc, 0xd2, 0xf2, 0xf5, 0x81,
+	0x63, 0x62, 0x16, 0xd3, 0x0b, 0x5a, 0x99, 0x12, 0xb6, 0x1c, 0x13, 0x87, 0x33, 0xaf, 0xfc, 0x6a,
+	0x33, 0xaf, 0x92, 0x98, 0x79, 0xa7, 0x61, 0xd1, 0xc3, 0x06, 0x71, 0x6c, 0xbe, 0x3f, 0xd6, 0x44,
+	0x8b, 0x0e, 0xcd, 0x08, 0x13, 0x42, 0x7b, 0x12, 0xe5, 0x9a, 0x68, 0x46, 0xca, 0xcc, 0xe5, 0xa9,
+	0x65, 0xe6, 0x84, 0x83, 0xbe, 0x44, 0x99, 0x59, 0x9b, 0x5a, 0x66, 0xce, 0x74, 0xce, 0x17, 0x16,
+	0xda, 0xf5, 0xd9, 0x0a, 0xed, 0x68, 0x5d, 0xba, 0x12, 0xab, 0x4b, 0xbf, 0xcf, 0xc9, 0xfa, 0x1b,
+	0x05, 0xd6, 0x53, 0xd3, 0x4a, 0x4c, 0xd7, 0x5b, 0x89, 0x93, 0xc0, 0x0b, 0x53, 0x7d, 0x16, 0x1c,
+	0x04, 0x3e, 0x8c, 0x1d, 0x04, 0x7e, 0x38, 0x5d, 0xf0, 0x8d, 0x9f, 0x03, 0xfe, 0x4f, 0x01, 0xde,
+	0xde, 0x77, 0xcd, 0x44, 0x85, 0x27, 0xb6, 0xfd, 0xb3, 0x27, 0x8e, 0xbb, 0xb2, 0xd6, 0x2f, 0xcc,
+	0x0b, 0xc8, 0x70, 0x39, 0xb4, 0x13, 0x96, 0xfb, 0xc5, 0xf9, 0xf1, 0x09, 0x29, 0x8b, 0xcc, 0x78,
+	0x10, 0xf3, 0xe2, 0xe3, 0x7e, 0x5a, 0xd5, 0x94, 0x57, 0xfe, 0x8e, 0x4f, 0x38, 0x54, 0x38, 0x9f,
+	0x6f, 0x80, 0xa8, 0x0f, 0x7f, 0x0a, 0x2b, 0x3b, 0x2f, 0xf1, 0xa0, 0x7f, 0x6c, 0x0f, 0xe6, 0x18,
+	0x87, 0x06, 0x14, 0x07, 0x23, 0x53, 0xe0, 0xd7, 0xf4, 0x67, 0xb4, 0xe4, 0x2d, 0xc6, 0x4b, 0x5e,
+	0x1d, 0x1a, 0x61, 0x0f, 0x22, 0x96, 0x4f, 0xd3, 0x58, 0x36, 0x29, 0x33, 0x55, 0xbe, 0xac, 0x89,
+	0x96, 0xa0, 0x63, 0x8f, 0x5f, 0x12, 0xe2, 0x74, 0xec, 0x79, 0xf1, 0xd4, 0x58, 0x8c, 0xa7, 0x46,
+	0xf5, 0xcf, 0x15, 0xa8, 0xd2, 0x1e, 0x5e, 0xcb, 0x7e, 0xb1, 0xaf, 0x2c, 0x86, 0xfb, 0xca, 0x60,
+	0x7b, 0x5a, 0x8a, 0x6e, 0x4f, 0x43, 0xcb, 0x17, 0x18, 0x39, 0x6d, 0xf9, 0x62, 0x40, 0xc7, 0x9e,
+	0xa7, 0x9e, 0x87, 0x65, 0x6e, 0x9b, 0x78, 0x73, 0x7a, 0x3d, 0xd0, 0x1b, 0xca, 0xf1, 0x1b, 0x7b,
+	0x43, 0xf5, 0xcf, 0x14, 0xa8, 0xb5, 0x7d, 0xdf, 0x18, 0x1c, 0xcd, 0xf1, 0x02, 0x81, 0x71, 0x85,
+	0xa8, 0x71, 0xe9, 0x97, 0x08, 0xcd, 0x2d, 0xe5, 0x98, 0xbb, 0x10, 0x33, 0x57, 0x85, 0xba, 0xb4,
+	0x25, 0xd7, 0xe0, 0x2e, 0xbd, 0x0b, 0xe9, 0xf9, 0x0f, 0x1c, 0xef, 0x85, 0xe1, 0x99, 0xf3, 0x6d,
+	0x37, 0x11, 0x94, 0xc4, 0xfd, 0xfd, 0xe2, 0x95, 0x05, 0x8d, 0xfd, 0x56, 0x2f, 0xc3, 0xc9, 0x98,
+	0xbe, 0xdc, 0x8e, 0xef, 0x41, 0x95, 0x2d, 0x72, 0x62, 0xdf, 0x71, 0x23, 0x7a, 0xcc, 0x38, 0xd3,
+	0x92, 0xa8, 0xfe, 0x21, 0xac, 0xd2, 0x62, 0x88, 0xd1, 0x83, 0xbc, 0xf3, 0xa3, 0x44, 0x51, 0x7e,
+	0x2e, 0x47, 0x51, 0xa2, 0x20, 0xff, 0xad, 0x02, 0x0b, 0x8c, 0x9e, 0x2a, 0x50, 0xce, 0x52, 0x18,
+	0xd4, 0x75, 0x74, 0xdf, 0x38, 0x0c, 0xbe, 0x96, 0xa0, 0x84, 0x3d, 0xe3, 0x90, 0x61, 0xf3, 0xec,
+	0xa1, 0x69, 0x1d, 0x62, 0xe2, 0xcb, 0x4f, 0x26, 0xaa, 0x94, 0xb6, 0xcd, 0x49, 0xd4, 0x49, 0xec,
+	0xd4, 0xab, 0xc4, 0x0e, 0xb7, 0xd8, 0x6f, 0xb4, 0xc1, 0xaf, 0x95, 0xce, 0x72, 0xd8, 0x41, 0x19,
+	0xe9, 0x2d, 0xcf, 0xc4, 0xf9, 0x44, 0xd0, 0x46, 0xd7, 0xa1, 0xc4, 0xf0, 0xdd, 0xa5, 0xe9, 0x7e,
+	0x63, 0x8c, 0xea, 0x0e, 0xa0, 0xa8, 0xdb, 0xc4, 0x00, 0x5d, 0x87, 0x45, 0xe6, 0x55, 0x59, 0x3b,
+	0xae, 0xe7, 0x28, 0xd2, 0x04, 0x9b, 0x6a, 0x00, 0xe2, 0x9a, 0x63, 0xf5, 0xe2, 0xfc, 0xc3, 0x38,
+	0xa1, 0x7e, 0xfc, 0x3b, 0x05, 0x4e, 0xc6, 0xfa, 0x10, 0xb6, 0x7e, 0x10, 0xef, 0x24, 0xd7, 0x54,
+	0xd1, 0xc1, 0x56, 0x6c, 0xc1, 0xbc, 0x9e, 0x67, 0xd2, 0x77, 0xb4, 0x58, 0xfe, 0xa3, 0x02, 0xd0,
+	0x1e, 0xfb, 0x47, 0x02, 0x37, 0x8d, 0x0e, 0xa5, 0x92, 0x18, 0xca, 0x16, 0x94, 0x5d, 0x83, 0x90,
+	0x17, 0x8e, 0x27, 0x77, 0x7c, 0x41, 0x9b, 0x21, 0x9c, 0x63, 0xff, 0x48, 0x9e, 0x6a, 0xd2, 0xdf,
+	0x14, 0xfd, 0xe5, 0x9f, 0xf4, 0xe8, 0x86, 0x69, 0x7a, 0x98, 0x10, 0x71, 0xbc, 0x59, 0xe3, 0xd4,
+	0x36, 0x27, 0x52, 0x36, 0x8b, 0x61, 0xfe, 0xfe, 0xb1, 0xee, 0x3b, 0xcf, 0xb0, 0x2d, 0x76, 0x6e,
+	0x35, 0x49, 0xdd, 0xa3, 0x44, 0x7e, 0x88, 0x74, 0x68, 0x11, 0xdf, 0x93, 0x6c, 0xf2, 0x28, 0x4c,
+	0x50, 0x19, 0x1b, 0x1d, 0x94, 0x46, 0x6f, 0x3c, 0x1c, 0x72, 0x17, 0xbf, 0xfa, 0xb0, 0xff, 0x50,
+	0xbc, 0x50, 0x21, 0x6f, 0x12, 0x84, 0x4e, 0x13, 0xaf, 0xfb, 0x06, 0x21, 0xaa, 0x1f, 0xc2, 0x6a,
+	0xe4, 0x1d, 0x44, 0x58, 0xc5, 0x4a, 0x6c, 0x25, 0x5e, 0x62, 0xab, 0x0f, 0x01, 0x71, 0x54, 0xe6,
+	0x35, 0xdf, 0x5b, 0x3d, 0x05, 0x27, 0x63, 0x8a, 0xc4, 0xd2, 0x7d, 0x0d, 0x6a, 0xe2, 0x4e, 0x9f,
+	0x08, 0x94, 0x33, 0x50, 0xa6, 0x29, 0x78, 0x60, 0x99, 0xf2, 0xc8, 0x7b, 0xc9, 0x75, 0xcc, 0x2d,
+	0xcb, 0xf4, 0xd4, 0x2f, 0xa0, 0x26, 0xbe, 0x0b, 0x10, 0xbc, 0x0f, 0xa0, 0x2e, 0x6e, 0x00, 0xea,
+	0xb1, 0xbb, 0xbd, 0x59, 0x5f, 0xe9, 0x44, 0x3b, 0xd1, 0x6a, 0x76, 0xb4, 0xa9, 0x9a, 0xd0, 0xe2,
+	0x35, 0x46, 0x4c, 0xbd, 0x7c, 0xd9, 0x07, 0x20, 0xaf, 0xbc, 0x4c, 0xed, 0x25, 0x2e, 0x5f, 0xf3,
+	0xa2, 0x4d, 0xf5, 0x1c, 0x9c, 0xcd, 0xec, 0x45, 0x78, 0xc2, 0x85, 0x46, 0xf8, 0x80, 0x5f, 0x40,
+	0x0d, 0xce, 0xf4, 0x95, 0xc8, 0x99, 0xfe, 0xe9, 0xa0, 0x84, 0x2e, 0xc8, 0x55, 0x8f, 0xb6, 0x22,
+	0x9b, 0xa1, 0x62, 0xde, 0x66, 0xa8, 0x14, 0xdb, 0x0c, 0xa9, 0xfd, 0xc0, 0x9f, 0x62, 0x93, 0x7a,
+	0x9f, 0x6d, 0xa6, 0x79, 0xdf, 0x32, 0x21, 0xaa, 0x93, 0xde, 0x92, 0xb3, 0x6a, 0x11, 0x29, 0xf5,
+	0x2a, 0xd4, 0xe2, 0xa9, 0x31, 0x92, 0xe7, 0x94, 0x54, 0x9e, 0xab, 0x27, 0x52, 0xdc, 0xc7, 0x89,
+	0xfd, 0x41, 0xbe, 0x8f, 0x13, 0xbb, 0x83, 0x3b, 0xb1, 0x64, 0x77, 0x2d, 0x2d, 0xf6, 0x5d, 0xe5,
+	0xb9, 0x35, 0xb1, 0x1e, 0x3c, 0x20, 0x54, 0x5e, 0xbc, 0xb4, 0x7a, 0x11, 0xaa, 0xfb, 0x79, 0x5f,
+	0x79, 0x95, 0x84, 0xb8, 0x7a, 0x13, 0xd6, 0x1e, 0x58, 0x43, 0x4c, 0x8e, 0x89, 0x8f, 0x47, 0x1d,
+	0x96, 0x94, 0x0e, 0x2c, 0xec, 0xd1, 0x5b, 0x0d, 0x6c, 0x83, 0xe7, 0x3a, 0x56, 0xf0, 0xbd, 0x4a,
+	0x84, 0xa2, 0xfe, 0x87, 0x02, 0x2b, 0xa1, 0xe0, 0x3e, 0xdb, 0xd8, 0xbe, 0x05, 0x15, 0xfa, 0xbe,
+	0xc4, 0x37, 0x46, 0xae, 0x3c, 0xed, 0x0b, 0x08, 0x14, 0xcd, 0x3c, 0x20, 0x12, 0x50, 0xcb, 0x3c,
+	0x5e, 0xc8, 0x32, 0x44, 0x2b, 0x1d, 0x90, 0x0e, 0xbd, 0x88, 0x08, 0x63, 0x82, 0x4d, 0x71, 0xc2,
+	0x57, 0xcc, 0x2b, 0x2f, 0xf6, 0xa3, 0x57, 0x15, 0xa8, 0x00, 0xbf, 0xc3, 0x73, 0x07, 0xaa, 0x96,
+	0xed, 0x98, 0x98, 0x1d, 0xdd, 0x9a, 0xcd, 0xd2, 0x2c, 0xe2, 0xc0, 0x25, 0xf6, 0x09, 0x36, 0x55,
+	0x0c, 0x27, 0x63, 0xfe, 0x15, 0x81, 0xd2, 0x85, 0x55, 0x9e, 0xb4, 0x0e, 0x02, 0xc3, 0x65, 0xc4,
+	0x5e, 0x98, 0xf4, 0x76, 0xcc, 0x5b, 0x5a, 0xc3, 0x12, 0xb5, 0x90, 0x14, 0xa5, 0xf0, 0x7b, 0x6c,
+	0xff, 0x38, 0xc7, 0x86, 0x4e, 0xed, 0x25, 0x60, 0xa4, 0x30, 0x9c, 0x05, 0x48, 0x23, 0xa3, 0x79,
+	0x1a, 0x48, 0x43, 0x38, 0x48, 0x43, 0xd4, 0xaf, 0xe1, 0x4c, 0x0c, 0xef, 0x8a, 0x59, 0x74, 0x27,
+	0x51, 0xea, 0xbd, 0x3b, 0x4d, 0x6b, 0xa2, 0xe6, 0xfb, 0x6f, 0x05, 0xd6, 0xb2, 0x18, 0x5e, 0x11,
+	0x8f, 0xfd, 0x69, 0xce, 0x4d, 0xd3, 0x5b, 0xb3, 0x99, 0xf5, 0x3b, 0xc1, 0xb2, 0xf7, 0xa0, 0x95,
+	0xe5, 0xcf, 0xf4, 0x28, 0x15, 0xe7, 0x19, 0xa5, 0x5f, 0x16, 0x23, 0xe7, 0x12, 0x6d, 0xdf, 0xf7,
+	0xac, 0xa7, 0x63, 0x1a, 0xf2, 0x6f, 0x1c, 0xeb, 0xeb, 0x04, 0xa8, 0x15, 0x77, 0xed, 0x8d, 0x09,
+	0xe2, 0xa1, 0x1d, 0x99, 0xc8, 0xd5, 0x97, 0x59, 0x9b, 0xfe, 0x9b, 0xb3, 0xe9, 0xfb, 0xbd, 0x85,
+	0x87, 0x7f, 0x59, 0x80, 0x7a, 0x7c, 0x88, 0xd0, 0x0e, 0x80, 0x11, 0x58, 0xde, 0x54, 0xa6, 0x1e,
+	0xe2, 0x84, 0xaf, 0xa9, 0x45, 0x04, 0xd1, 0xfb, 0x50, 0x1c, 0xb8, 0x63, 0x31, 0x6a, 0x19, 0x47,
+	0xe5, 0x5b, 0xee, 0x98, 0x67, 0x14, 0xca, 0x46, 0x37, 0x61, 0xfc, 0xe6, 0x43, 0x7e, 0x96, 0x7c,
+	0xc2, 0x9e, 0x73, 0x19, 0xc1, 0x8c, 0x1e, 0x41, 0xfd, 0x85, 0x67, 0xf9, 0xc6, 0xd3, 0x21, 0xd6,
+	0x87, 0xc6, 0x31, 0xf6, 0x44, 0x96, 0x9c, 0x21, 0x91, 0xd5, 0xa4, 0xe0, 0x63, 0x2a, 0xa7, 0xfe,
+	0x09, 0x94, 0xa5, 0x45, 0x53, 0x56, 0x84, 0x3d, 0x58, 0x1f, 0x53, 0x36, 0x9d, 0xdd, 0xad, 0xb4,
+	0x0d, 0xdb, 0xd1, 0x09, 0xa6, 0xcb, 0xb8, 0xfc, 0xb0, 0x65, 0x4a, 0x8a, 0x5e, 0x63, 0xd2, 0x5b,
+	0x8e, 0x87, 0xbb, 0x86, 0xed, 0xf4, 0xb9, 0xa8, 0xfa, 0x1c, 0xaa, 0x91, 0x17, 0x9c, 0x62, 0x42,
+	0x07, 0x56, 0xe5, 0x45, 0x05, 0x7a, 0x3b, 0x93, 0x2f, 0x2f, 0x33, 0x75, 0xbe, 0x22, 0xe4, 0xfa,
+	0xd8, 0xe7, 0x97, 0x4b, 0xee, 0xc0, 0x19, 0x0d, 0x3b, 0x2e, 0xb6, 0x83, 0xf1, 0x7c, 0xec, 0x1c,
+	0xce, 0x91, 0xc1, 0xdf, 0x82, 0x56, 0x96, 0x3c, 0xcf, 0x0f, 0xd7, 0xde, 0x85, 0xb2, 0xfc, 0x9e,
+	0x1f, 0x2d, 0x41, 0x71, 0x6f, 0xab, 0xd7, 0x38, 0x41, 0x7f, 0xec, 0x6f, 0xf7, 0x1a, 0x0a, 0x2a,
+	0x43, 0xa9, 0xbf, 0xb5, 0xd7, 0x6b, 0x14, 0xae, 0x8d, 0xa0, 0x91, 0xfc, 0x98, 0x1d, 0xad, 0xc3,
+	0xc9, 0x9e, 0xb6, 0xdb, 0x6b, 0x3f, 0x6c, 0xef, 0x75, 0x76, 0xbb, 0x7a, 0x4f, 0xeb, 0x7c, 0xde,
+	0xde, 0xdb, 0x69, 0x9c, 0x40, 0x17, 0xe0, 0x5c, 0xf4, 0xc1, 0xa3, 0xdd, 0xfe, 0x9e, 0xbe, 0xb7,
+	0xab, 0x6f, 0xed, 0x76, 0xf7, 0xda, 0x9d, 0xee, 0x8e, 0xd6, 0x50, 0xd0, 0x39, 0x38, 0x13, 0x65,
+	0xb9, 0xdf, 0xd9, 0xee, 0x68, 0x3b, 0x5b, 0xf4, 0x77, 0xfb, 0x71, 0xa3, 0x70, 0xed, 0x33, 0xa8,
+	0xc5, 0xbe, 0x3d, 0xa7, 0x26, 0xf5, 0x76, 0xb7, 0x1b, 0x27, 0xe8, 0xc7, 0xb1, 0x51, 0x3d, 0x65,
+	0x28, 0x75, 0x77, 0xb7, 0x77, 0x1a, 0x05, 0x04, 0xb0, 0xb8, 0xd7, 0xd6, 0x1e, 0xee, 0xec, 0x35,
+	0x8a, 0xd7, 0x6e, 0xc3, 0x4a, 0xe2, 0xb2, 0x3a, 0x5a, 0x85, 0x5a, 0xbf, 0xdd, 0xdd, 0xbe, 0xbf,
+	0xfb, 0xa5, 0xae, 0xed, 0xb4, 0xb7, 0xbf, 0x6a, 0x9c, 0x40, 0x6b, 0xd0, 0x90, 0xa4, 0xee, 0xee,
+	0x1e, 0xa7, 0x2a, 0xd7, 0x9e, 0x25, 0xe6, 0x1b, 0x46, 0xa7, 0x60, 0x35, 0xe8, 0x52, 0xdf, 0xd2,
+	0x76, 0xda, 0x7b, 0x3b, 0xd4, 0x92, 0x18, 0x59, 0xdb, 0xef, 0x76, 0x3b, 0xdd, 0x87, 0x0d, 0x85,
+	0x6a, 0x0d, 0xc9, 0x3b, 0x5f, 0x76, 0x28, 0x73, 0x21, 0xce, 0xbc, 0xdf, 0xfd, 0x71, 0x77, 0xf7,
+	0x8b, 0x6e, 0xa3, 0xb8, 0xf9, 0xeb, 0xd5, 0xe0, 0x7b, 0xe0, 0x3e, 0xf6, 0xd8, 0xfd, 0x9f, 0x1e,
+	0x2c, 0xc9, 0xff, 0x15, 0x91, 0x91, 0xad, 0xe3, 0xff, 0xe1, 0xa2, 0x75, 0x61, 0x02, 0x87, 0xa8,
+	0xbd, 0x4f, 0xa0, 0xa7, 0xac, 0x16, 0x0e, 0x1d, 0x82, 0xde, 0xcd, 0xac, 0x3c, 0x53, 0xdf, 0x2b,
+	0xb4, 0x2e, 0x4f, 0xe5, 0x0b, 0xfa, 0xc0, 0x50, 0x8f, 0x7f, 0x9f, 0x87, 0x2e, 0x67, 0xd5, 0xa9,
+	0x19, 0x1f, 0x00, 0xb6, 0xae, 0x4c, 0x67, 0x0c, 0xba, 0x79, 0x06, 0x8d, 0xe4, 0xb7, 0x7a, 0x28,
+	0x03, 0x64, 0xce, 0xf9, 0x20, 0xb0, 0x75, 0x6d, 0x16, 0xd6, 0x68, 0x67, 0xa9, 0x8f, 0xcf, 0xae,
+	0xce, 0xf2, 0x91, 0x4e, 0x6e, 0x67, 0x79, 0xdf, 0xf3, 0x70, 0x07, 0xc6, 0xef, 0xfb, 0xa3, 0xcc,
+	0x2f, 0xbd, 0x88, 0x3f, 0x93, 0x03, 0xb3, 0x3f, 0x1d, 0x50, 0x4f, 0xa0, 0x23, 0x58, 0x49, 0x5c,
+	0xe4, 0x40, 0x19, 0xe2, 0xd9, 0x37, 0x56, 0x5a, 0x57, 0x67, 0xe0, 0x8c, 0x47, 0x44, 0xf4, 0xe2,
+	0x46, 0x76, 0x44, 0x64, 0x5c, 0x0b, 0x69, 0x5d, 0x99, 0xce, 0x18, 0x0d, 0xee, 0xd8, 0x85, 0x8d,
+	0xac, 0xe0, 0xce, 0xba, 0x26, 0xd2, 0xba, 0x3c, 0x95, 0x2f, 0xea, 0xb4, 0xc4, 0xf5, 0x8d, 0x2c,
+	0xa7, 0x65, 0x5f, 0x0f, 0x69, 0x5d, 0x9d, 0x81, 0x33, 0x19, 0x05, 0xc1, 0x23, 0x92, 0x17, 0x05,
+	0xa9, 0xab, 0x0b, 0xad, 0x2b, 0xd3, 0x19, 0x63, 0x51, 0x90, 0x38, 0xc4, 0xbd, 0x32, 0xc3, 0xa1,
+	0x53, 0x7e, 0x14, 0x64, 0x1f, 0x4f, 0xa9, 0x27, 0xd0, 0x2f, 0x14, 0x68, 0xe6, 0x9d, 0x71, 0xa0,
+	0x1b, 0x73, 0x1f, 0xc8, 0xb4, 0x36, 0xe7, 0x11, 0x09, 0xac, 0xf8, 0x16, 0x50, 0x7a, 0x0d, 0x44,
+	0xef, 0x65, 0x8d, 0x4c, 0xce, 0x4a, 0xdb, 0x7a, 0x7f, 0x36, 0xe6, 0xa0, 0xcb, 0x3e, 0x94, 0xe5,
+	0xa9, 0x0a, 0xca, 0xc8, 0xd2, 0x89, 0x33, 0x9d, 0x96, 0x3a, 0x89, 0x25, 0x50, 0xfa, 0x10, 0x4a,
+	0x94, 0x8a, 0xce, 0x65, 0x73, 0x4b, 0x65, 0x3f, 0xc8, 0x7b, 0x1c, 0x28, 0x7a, 0x02, 0x8b, 0xfc,
+	0x18, 0x01, 0x65, 0xa0, 0x10, 0xb1, 0xc3, 0x8e, 0xd6, 0xf9, 0x7c, 0x86, 0x40, 0xdd, 0x37, 0x50,
+	0x8d, 0x9c, 0x10, 0xa0, 0x77, 0xb2, 0xff, 0x5b, 0x40, 0xfc, 0x40, 0xa2, 0x75, 0x69, 0x0a, 0x57,
+	0x74, 0x52, 0x24, 0x2a, 0xe0, 0xcb, 0x53, 0xb7, 0x31, 0xf9, 0x93, 0x22, 0x7b, 0xa3, 0xc4, 0x83,
+	0x24, 0xbd, 0x91, 0xca, 0x0a, 0x92, 0xdc, 0xed, 0x6b, 0xeb, 0xfd, 0xd9, 0x98, 0x83, 0x2e, 0x7d,
+	0x38, 0x99, 0x01, 0x9b, 0xa1, 0xf7, 0xf3, 0x82, 0x3c, 0x0b, 0xc3, 0x6b, 0x7d, 0x30, 0x23, 0x77,
+	0x74, 0xf0, 0xc5, 0xa4, 0x7f, 0x3b, 0x1f, 0x4b, 0xca, 0x1d, 0xfc, 0xe4, 0x14, 0xdf, 0xfc, 0xd7,
+	0x22, 0x2c, 0x73, 0x48, 0x54, 0x54, 0x30, 0x5f, 0x01, 0x84, 0xa7, 0x11, 0xe8, 0x62, 0xb6, 0x4f,
+	0x62, 0x47, 0x3c, 0xad, 0x77, 0x26, 0x33, 0x45, 0x03, 0x2d, 0x82, 0xec, 0x67, 0x05, 0x5a, 0xfa,
+	0x00, 0xa3, 0x75, 0x69, 0x0a, 0x57, 0xa0, 0xfd, 0x73, 0xa8, 0x04, 0x10, 0x32, 0xca, 0x82, 0xa0,
+	0x13, 0x18, 0x79, 0xeb, 0xe2, 0x44, 0x9e, 0xa8, 0xd5, 0x11, 0x7c, 0x38, 0xcb, 0xea, 0x34, 0x0e,
+	0xdd, 0xba, 0x34, 0x85, 0x2b, 0xe5, 0x13, 0x8e, 0x22, 0xe5, 0xfa, 0x24, 0x06, 0xe2, 0xb5, 0x2e,
+	0x4d, 0xe1, 0x92, 0xda, 0xef, 0xc3, 0x4f, 0xca, 0x92, 0xe3, 0xe9, 0x22, 0xfb, 0x87, 0x5f, 0x1f,
+	0xfe, 0xdf, 0x00, 0xa2, 0xf1, 0x7b, 0xe6, 0x87, 0x4d, 0x00, 0x00,
+}
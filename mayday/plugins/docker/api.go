@@ -0,0 +1,164 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+const outputDir = "/docker/"
+
+const (
+	defaultSocket = "/var/run/docker.sock"
+	defaultRoot   = "/var/lib/docker"
+	apiTimeout    = 30 * time.Second
+)
+
+type Config struct {
+	Socket       string        `mapstructure:"socket"`        // the Engine API socket
+	Root         string        `mapstructure:"root"`          // the daemon's data directory
+	DaemonConfig string        `mapstructure:"daemon_config"` // the daemon's configuration file
+	ScanFiles    int           `mapstructure:"scan_files"`    // files looked at to measure the disk usage of root
+	ScanTime     time.Duration `mapstructure:"scan_time"`     // time spent measuring the disk usage of root
+	Logs         Logs          `mapstructure:"logs"`          // collected with --danger
+}
+
+func (c Config) withDefaults() Config {
+	if c.Socket == "" {
+		c.Socket = defaultSocket
+	}
+	if c.Root == "" {
+		c.Root = defaultRoot
+	}
+	if c.DaemonConfig == "" {
+		c.DaemonConfig = defaultDaemonConfig
+	}
+	if c.ScanFiles == 0 {
+		c.ScanFiles = defaultScanFiles
+	}
+	if c.ScanTime == 0 {
+		c.ScanTime = defaultScanTime
+	}
+	return c
+}
+
+// Client talks to the Docker Engine API over a unix socket
+type Client struct {
+	http *http.Client
+}
+
+func NewClient(socket string) *Client {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socket)
+		},
+	}
+	return &Client{http: &http.Client{Transport: transport, Timeout: apiTimeout}}
+}
+
+// get returns the body of a successful GET of path
+func (c *Client) get(path string) ([]byte, error) {
+	// the host is ignored when dialing the socket
+	resp, err := c.http.Get("http://docker" + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		// errors are {"message": "..."}
+		var e struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &e) == nil && e.Message != "" {
+			return nil, fmt.Errorf("GET %s: %s", path, e.Message)
+		}
+		return nil, fmt.Errorf("GET %s: %s", path, resp.Status)
+	}
+	return body, nil
+}
+
+// containerIDs lists every container, running or not
+func (c *Client) containerIDs() ([]string, error) {
+	body, err := c.get("/containers/json?all=1")
+	if err != nil {
+		return nil, err
+	}
+	var list []struct {
+		ID string `json:"Id"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, l := range list {
+		ids = append(ids, l.ID)
+	}
+	return ids, nil
+}
+
+// endpoints are the daemon wide API calls collected, by output name
+var endpoints = []struct {
+	name string
+	path string
+}{
+	{"info", "/info"},
+	{"version", "/version"},
+	{"images", "/images/json?all=1&digests=1"},
+	{"networks", "/networks"},
+	{"volumes", "/volumes"},
+	{"df", "/system/df"},
+}
+
+// GetDaemon collects the daemon's info, version, images, networks, volumes and
+// disk usage through the Engine API, and the status of its storage
+func GetDaemon(c Config) ([]*tarable.Output, error) {
+	c = c.withDefaults()
+	client := NewClient(c.Socket)
+
+	// fail early, and only once, when the daemon isn't there
+	if _, err := client.get("/_ping"); err != nil {
+		return nil, err
+	}
+
+	var outputs []*tarable.Output
+	var info []byte
+	for _, e := range endpoints {
+		body, err := client.get(e.path)
+		if err != nil {
+			// e.g. /system/df needs API 1.25
+			body = errorJSON(err)
+		}
+		if e.name == "info" {
+			info = body
+		}
+		outputs = append(outputs, tarable.NewOutput(outputDir, e.name+".json", "", tarable.Bytes(indent(body))))
+	}
+	outputs = append(outputs, tarable.NewOutput(outputDir, "storage", "docker_storage", tarable.Bytes(storage(client, info, c.Root))))
+	return outputs, nil
+}
+
+func indent(body []byte) []byte {
+	var b bytes.Buffer
+	if err := json.Indent(&b, body, "", "  "); err != nil {
+		return body
+	}
+	return b.Bytes()
+}
+
+func errorJSON(err error) []byte {
+	b, _ := json.Marshal(map[string]string{"error": err.Error()})
+	return b
+}
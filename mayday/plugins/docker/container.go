@@ -0,0 +1,328 @@
+package docker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/coreos/mayday/mayday/containers"
+	"github.com/coreos/mayday/mayday/scrub"
+	"github.com/coreos/mayday/mayday/tarable"
+	"github.com/spf13/viper"
+)
+
+// inspect is what a container's inspection, or its config.v2.json, says about
+// its runtime
+type inspect struct {
+	ID             string
+	Name           string
+	Created        string
+	RestartCount   int
+	ResolvConfPath string
+	HostsPath      string
+	HostnamePath   string
+	LogPath        string
+	Config         struct {
+		Image string
+	}
+	State           state
+	HostConfig      json.RawMessage
+	NetworkSettings struct {
+		Networks map[string]struct {
+			IPAddress         string
+			GlobalIPv6Address string
+		}
+	}
+	Mounts      []mount          // Engine API
+	MountPoints map[string]mount // config.v2.json
+}
+
+type state struct {
+	Status     string
+	Running    bool
+	Paused     bool
+	Restarting bool
+	OOMKilled  bool
+	Dead       bool
+	Pid        int
+	ExitCode   int
+	Error      string
+	StartedAt  string
+	FinishedAt string
+	Health     *health `json:",omitempty"`
+}
+
+type health struct {
+	Status        string
+	FailingStreak int
+	Log           []json.RawMessage
+}
+
+type mount struct {
+	Type        string
+	Name        string
+	Source      string
+	Destination string
+	Driver      string
+	Mode        string
+	RW          bool
+	Propagation string
+}
+
+// hostConfig is the part of the host configuration read by mayday
+type hostConfig struct {
+	LogConfig struct {
+		Type string
+	}
+	RestartPolicy json.RawMessage
+}
+
+// parse reads a container's configuration and, when stored separately, its
+// host configuration. dir is the container's directory in the daemon's data
+// directory.
+func (d *DockerContainer) parse(config, hostConfigJSON []byte, dir string) {
+	d.dir = dir
+	var info inspect
+	if err := json.Unmarshal(config, &info); err != nil {
+		log.Printf("error parsing docker container configuration of %s: %s", d.containerId, err)
+	}
+	if hostConfigJSON != nil {
+		info.HostConfig = hostConfigJSON
+	}
+	d.info = &info
+
+	var hc hostConfig
+	json.Unmarshal(info.HostConfig, &hc)
+	d.logPath = info.LogPath
+	d.logDriver = hc.LogConfig.Type
+	if d.logDriver == "" {
+		d.logDriver = jsonFileDriver
+	}
+}
+
+// status is the container's state as docker ps shows it
+func (s state) status() string {
+	switch {
+	case s.Status != "":
+		return s.Status
+	case s.Paused:
+		return "paused"
+	case s.Restarting:
+		return "restarting"
+	case s.Running:
+		return "running"
+	case s.Dead:
+		return "dead"
+	case s.StartedAt == "" || strings.HasPrefix(s.StartedAt, "0001-01-01"):
+		return "created"
+	}
+	return "exited"
+}
+
+func (i *inspect) mounts() []mount {
+	if i.Mounts != nil {
+		return i.Mounts
+	}
+	var ms []mount
+	for _, m := range i.MountPoints {
+		ms = append(ms, m)
+	}
+	sort.Slice(ms, func(a, b int) bool { return ms[a].Destination < ms[b].Destination })
+	return ms
+}
+
+// Containers describes the containers the way every runtime's are
+func Containers(cs []*DockerContainer) []*containers.Container {
+	var described []*containers.Container
+	for _, d := range cs {
+		c := &containers.Container{Runtime: "docker", Id: d.Name(), State: containers.Unknown, Dump: "docker/" + d.Name() + "/config.json"}
+		if viper.GetBool("danger") && d.logDriver == jsonFileDriver {
+			c.Logs = "docker/" + d.Name() + ".log"
+		}
+		described = append(described, c)
+		if d.info == nil {
+			continue
+		}
+		i := d.info
+		c.Name = strings.TrimPrefix(i.Name, "/")
+		c.Image = i.Config.Image
+		c.State = i.State.status()
+		c.ExitCode = i.State.ExitCode
+		c.Created = parseTime(i.Created)
+		c.Started = parseTime(i.State.StartedAt)
+		if c.State == containers.Exited || c.State == containers.Dead {
+			c.Exited = parseTime(i.State.FinishedAt)
+		}
+		c.RestartCount = i.RestartCount
+		var names []string
+		for n := range i.NetworkSettings.Networks {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		for _, n := range names {
+			net := i.NetworkSettings.Networks[n]
+			c.Networks = append(c.Networks, containers.Network{Name: n, IPv4: net.IPAddress, IPv6: net.GlobalIPv6Address})
+		}
+		for _, m := range i.mounts() {
+			source := m.Source
+			if m.Type == "volume" && m.Name != "" {
+				source = m.Name
+			}
+			c.Mounts = append(c.Mounts, containers.Mount{Source: source, Destination: m.Destination, ReadOnly: !m.RW})
+		}
+	}
+	return described
+}
+
+// parseTime reads the times of an inspection, where the zero time means never
+func parseTime(s string) *time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil
+	}
+	return containers.Time(t)
+}
+
+// Files returns a summary of the containers, and the host configuration,
+// runtime files, mounts and state of each one under /docker/<id>/
+func Files(containers []*DockerContainer) []*tarable.Output {
+	outputs := []*tarable.Output{
+		tarable.NewOutput(outputDir, "containers", "docker_containers", func() []byte { return summary(containers) }),
+	}
+	for _, c := range containers {
+		if c.info == nil {
+			continue
+		}
+		d := c
+		id := d.Name()
+		if d.info.HostConfig != nil {
+			outputs = append(outputs, tarable.NewOutput(outputDir, id+"/hostconfig.json", "", func() []byte { return scrubJSON(d.info.HostConfig) }))
+		}
+		for _, f := range []struct{ name, path string }{
+			{"resolv.conf", d.info.ResolvConfPath},
+			{"hosts", d.info.HostsPath},
+			{"hostname", d.info.HostnamePath},
+		} {
+			path := f.path
+			if path == "" {
+				if d.dir == "" {
+					continue
+				}
+				path = filepath.Join(d.dir, f.name)
+			}
+			outputs = append(outputs, tarable.NewOutput(outputDir, id+"/"+f.name, "", func() []byte { return readRuntimeFile(path) }))
+		}
+		outputs = append(outputs,
+			tarable.NewOutput(outputDir, id+"/mounts", "", func() []byte { return mountsText(d.info.mounts()) }),
+			tarable.NewOutput(outputDir, id+"/state.json", "", func() []byte { return stateJSON(d.info) }),
+		)
+	}
+	return outputs
+}
+
+func readRuntimeFile(path string) []byte {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Printf("error reading container file: %s", err)
+		return []byte(fmt.Sprintf("error reading %s: %s\n", path, err))
+	}
+	return b
+}
+
+func summary(containers []*DockerContainer) []byte {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CONTAINER\tNAME\tIMAGE\tSTATUS\tEXIT\tRESTARTS\tHEALTH\tLOGS")
+	for _, c := range containers {
+		id := c.Name()
+		if len(id) > 12 {
+			id = id[:12]
+		}
+		if c.info == nil {
+			fmt.Fprintf(w, "%s\t-\t-\tunknown\t-\t-\t-\t-\n", id)
+			continue
+		}
+		i := c.info
+		health := "-"
+		if i.State.Health != nil {
+			health = i.State.Health.Status
+		}
+		name := strings.TrimPrefix(i.Name, "/")
+		if name == "" {
+			name = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%s\t%s\n",
+			id, name, i.Config.Image, i.State.status(), i.State.ExitCode, i.RestartCount, health, c.logDriver)
+	}
+	w.Flush()
+	return b.Bytes()
+}
+
+func mountsText(mounts []mount) []byte {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tSOURCE\tDESTINATION\tMODE\tRW\tPROPAGATION")
+	for _, m := range mounts {
+		source := m.Source
+		if m.Name != "" && m.Type == "volume" {
+			source = m.Name
+			if m.Driver != "" {
+				source += " (" + m.Driver + ")"
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			orDash(m.Type), orDash(source), m.Destination, orDash(m.Mode), strconv.FormatBool(m.RW), orDash(m.Propagation))
+	}
+	w.Flush()
+	return b.Bytes()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func stateJSON(i *inspect) []byte {
+	var hc hostConfig
+	json.Unmarshal(i.HostConfig, &hc)
+	s := i.State
+	s.Status = s.status()
+	b, err := json.MarshalIndent(struct {
+		State         state           `json:"state"`
+		RestartCount  int             `json:"restart_count"`
+		RestartPolicy json.RawMessage `json:"restart_policy,omitempty"`
+	}{s, i.RestartCount, hc.RestartPolicy}, "", "  ")
+	if err != nil {
+		log.Printf("error marshalling container state: %s", err)
+		return []byte("json marshal error")
+	}
+	return b
+}
+
+// scrubJSON returns an indented configuration, such as a host configuration or
+// daemon.json, with the values of settings that may hold credentials scrubbed
+// unless in danger mode
+func scrubJSON(raw []byte) []byte {
+	if viper.GetBool("danger") {
+		return indent(raw)
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return errUnrecognizedFormat
+	}
+	b, err := json.MarshalIndent(scrub.Secrets(v), "", "  ")
+	if err != nil {
+		return []byte("json marshal error")
+	}
+	return b
+}
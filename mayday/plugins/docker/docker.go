@@ -7,18 +7,13 @@ import (
 	"io"
 	"io/ioutil"
 	"log"
-	"os"
-	"strings"
+	"path/filepath"
 	"time"
 
-	"github.com/coreos/mayday/mayday/plugins/command"
+	"github.com/coreos/mayday/mayday/scrub"
 	"github.com/spf13/viper"
 )
 
-const (
-	dockerDir = "/var/lib/docker/containers"
-)
-
 // errUnrecognizedFormat is the content that will be returned when a given bit
 // of content is malformed in a config v2 map
 // In a better world, we would return (bytes, err) for Content, but how tarable
@@ -30,6 +25,11 @@ type DockerContainer struct {
 	file        io.Reader     // config file -- /var/lib/docker/containers/{uuid}/config.v2.json
 	content     *bytes.Buffer // a Buffer containing the contents of the file
 	link        string        // a link to make in the root of the tarball
+	dir         string        // the container's directory in the daemon's data directory
+	info        *inspect      // what the configuration says about the container's runtime
+	logPath     string        // the json-file log
+	logDriver   string
+	env         *scrub.Scrubber
 }
 
 func New(f io.Reader, uuid string) DockerContainer {
@@ -56,44 +56,41 @@ func (d *DockerContainer) Content() *bytes.Buffer {
 		log.Printf("error reading docker container configuration: %s", err)
 		return bytes.NewBuffer(errUnrecognizedFormat)
 	}
+	// an inspection includes the host configuration, which Files stores
+	// scrubbed as hostconfig.json, with log and volume driver credentials
+	delete(config, "HostConfig")
+
 	configData, ok := config["Config"]
 	if !ok {
 		log.Printf("unrecognized docker config for container %q: no Config key", d.containerId)
 		return bytes.NewBuffer(errUnrecognizedFormat)
 	}
 
-	if !viper.GetBool("danger") {
-		// config.Config is also of type string->???; delay ??? decoding
-		var configConfig map[string]json.RawMessage
-		if err := json.Unmarshal(configData, &configConfig); err != nil {
-			log.Printf("unrecognized docker config.Config for container %q: %v", d.containerId, err)
-			return bytes.NewBuffer(errUnrecognizedFormat)
+	// config.Config is also of type string->???; delay ??? decoding
+	var configConfig map[string]json.RawMessage
+	if err := json.Unmarshal(configData, &configConfig); err != nil {
+		log.Printf("unrecognized docker config.Config for container %q: %v", d.containerId, err)
+		return bytes.NewBuffer(errUnrecognizedFormat)
+	}
+	if configEnv, ok := configConfig["Env"]; ok {
+		var envString []string
+		if err := json.Unmarshal(configEnv, &envString); err != nil {
+			log.Printf("error parsing docker environment variables for %q: %v", d.containerId, err)
+			return bytes.NewBuffer([]byte("could not unmarshal Env"))
 		}
-		if configEnv, ok := configConfig["Env"]; ok {
-			var envString []string
-			if err := json.Unmarshal(configEnv, &envString); err != nil {
-				log.Printf("error parsing docker environment variables for %q: %v", d.containerId, err)
-				return bytes.NewBuffer([]byte("could not unmarshal Env"))
-			}
-			var newEnv []string
-			for _, e := range envString {
-				varSplit := strings.SplitAfterN(e, "=", 2)
-				newEnv = append(newEnv, varSplit[0]+"scrubbed by mayday")
-			}
-
-			newEnvRaw, err := json.Marshal(newEnv)
-			if err != nil {
-				log.Print("error marshalling new env: %v", err)
-				return bytes.NewBuffer([]byte("could not unmarshal Env"))
-			}
-			configConfig["Env"] = newEnvRaw
-			configConfigRaw, err := json.Marshal(configConfig)
-			if err != nil {
-				log.Print("error marshalling new config: %v", err)
-				return bytes.NewBuffer([]byte("json marshal error"))
-			}
-			config["Config"] = configConfigRaw
+
+		newEnvRaw, err := json.Marshal(d.env.Env(envString))
+		if err != nil {
+			log.Printf("error marshalling new env: %v", err)
+			return bytes.NewBuffer([]byte("could not unmarshal Env"))
 		}
+		configConfig["Env"] = newEnvRaw
+		configConfigRaw, err := json.Marshal(configConfig)
+		if err != nil {
+			log.Printf("error marshalling new config: %v", err)
+			return bytes.NewBuffer([]byte("json marshal error"))
+		}
+		config["Config"] = configConfigRaw
 	}
 
 	byteContent, err := json.MarshalIndent(config, "", "  ")
@@ -112,7 +109,7 @@ func (d *DockerContainer) Header() *tar.Header {
 	}
 
 	var header tar.Header
-	header.Name = "/docker/" + d.containerId
+	header.Name = "/docker/" + d.containerId + "/config.json"
 	header.Size = int64(d.content.Len())
 	header.Mode = 0666
 	header.ModTime = time.Now()
@@ -128,42 +125,77 @@ func (d *DockerContainer) Link() string {
 	return d.link
 }
 
-func getLogs(containers []*DockerContainer) []*command.Command {
-	var logs []*command.Command
+func getLogs(containers []*DockerContainer, limits Logs) []*Log {
+	var logs []*Log
 	if viper.GetBool("danger") {
 		log.Println("Danger mode activated. Dump will include docker container logs and environment variables, which may contain sensitive information.")
-		if len(containers) != 0 {
-			for _, c := range containers {
-				logcmd := []string{"docker", "logs", c.Name()}
-				cmd := command.New(logcmd, "")
-				cmd.Output = "/docker/" + c.Name() + ".log"
-				logs = append(logs, cmd)
-			}
+		for _, c := range containers {
+			logs = append(logs, &Log{id: c.Name(), path: c.logPath, driver: c.logDriver, limits: limits})
 		}
 	}
 	return logs
 }
 
-func GetContainers() ([]*DockerContainer, []*command.Command, error) {
+// GetContainers inspects every container through the Engine API, or reads
+// their configuration from the daemon's data directory when the API can't be
+// reached. Their environments are scrubbed by env.
+func GetContainers(c Config, env *scrub.Scrubber) ([]*DockerContainer, []*Log, error) {
+	c = c.withDefaults()
+
+	dir := filepath.Join(c.Root, "containers")
+	containers, err := apiContainers(NewClient(c.Socket), dir)
+	if err != nil {
+		log.Printf("Could not reach the docker API at %s (%s), reading %s instead", c.Socket, err, c.Root)
+		if containers, err = fileContainers(dir); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, dc := range containers {
+		dc.env = env
+	}
+	return containers, getLogs(containers, c.Logs), nil
+}
+
+func apiContainers(client *Client, dir string) ([]*DockerContainer, error) {
+	ids, err := client.containerIDs()
+	if err != nil {
+		return nil, err
+	}
+
 	var containers []*DockerContainer
-	var logs []*command.Command
+	for _, id := range ids {
+		inspect, err := client.get("/containers/" + id + "/json")
+		if err != nil {
+			// e.g. removed since it was listed
+			log.Printf("unable to inspect container %s: %s", id, err)
+			continue
+		}
+		dc := New(bytes.NewReader(inspect), id)
+		dc.parse(inspect, nil, filepath.Join(dir, id))
+		containers = append(containers, &dc)
+	}
+	return containers, nil
+}
 
-	files, err := ioutil.ReadDir(dockerDir)
+func fileContainers(dir string) ([]*DockerContainer, error) {
+	var containers []*DockerContainer
+
+	files, err := ioutil.ReadDir(dir)
 	if err != nil {
-		return containers, logs, err
+		return containers, err
 	}
 
 	for _, file := range files {
-		f, err := os.Open(dockerDir + "/" + file.Name() + "/config.v2.json")
+		config, err := ioutil.ReadFile(filepath.Join(dir, file.Name(), "config.v2.json"))
 		if err != nil {
 			log.Printf("unable to read config for container %s: %s", file.Name(), err)
 			continue
 		}
-		dc := New(f, file.Name())
+		dc := New(bytes.NewReader(config), file.Name())
+		hostConfig, _ := ioutil.ReadFile(filepath.Join(dir, file.Name(), "hostconfig.json"))
+		dc.parse(config, hostConfig, filepath.Join(dir, file.Name()))
 		containers = append(containers, &dc)
 	}
-
-	logs = getLogs(containers)
-
-	return containers, logs, nil
+	return containers, nil
 }
@@ -0,0 +1,303 @@
+package docker
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+const (
+	defaultLogTail  = 1000
+	defaultLogMaxKB = 1024
+
+	jsonFileDriver = "json-file"
+)
+
+// Logs bounds how much of each container's log is collected
+type Logs struct {
+	Tail  int           `mapstructure:"tail"`   // the last lines of each log
+	Since time.Duration `mapstructure:"since"`  // only lines logged within this window
+	MaxKB int           `mapstructure:"max_kb"` // per container, older lines are dropped first
+}
+
+func (l Logs) withDefaults() Logs {
+	if l.Tail == 0 {
+		l.Tail = defaultLogTail
+	}
+	if l.MaxKB == 0 {
+		l.MaxKB = defaultLogMaxKB
+	}
+	return l
+}
+
+var now = time.Now
+
+// Log is the tail of a container's log, read from the files the json-file
+// log driver writes
+type Log struct {
+	id      string
+	path    string // the current log file, rotated files end in .1, .2, ...
+	driver  string
+	limits  Logs
+	content *bytes.Buffer
+}
+
+// logLine is a line of a json-file log
+type logLine struct {
+	Log    string    `json:"log"`
+	Stream string    `json:"stream"`
+	Time   time.Time `json:"time"`
+}
+
+func (l *Log) Content() *bytes.Buffer {
+	if l.content != nil {
+		return l.content
+	}
+	if l.driver != jsonFileDriver || l.path == "" {
+		l.content = bytes.NewBufferString(fmt.Sprintf("container %s uses the %q log driver, its logs can't be read from disk\n", l.id, l.driver))
+		return l.content
+	}
+
+	log.Printf("Collecting logs of container %s", l.id)
+	lines, truncated, err := tail(l.path, l.limits)
+	var b bytes.Buffer
+	if err != nil {
+		log.Printf("error reading logs of container %s: %s", l.id, err)
+		fmt.Fprintf(&b, "error reading %s: %s\n", l.path, err)
+	}
+	if truncated {
+		b.WriteString("[earlier lines left out by mayday]\n")
+	}
+	for _, line := range lines {
+		b.WriteString(line)
+	}
+	l.content = &b
+	return l.content
+}
+
+func (l *Log) Header() *tar.Header {
+	return tarable.Header(l.Content(), l.Name())
+}
+
+func (l *Log) Name() string {
+	return "/docker/" + l.id + ".log"
+}
+
+func (l *Log) Link() string {
+	return ""
+}
+
+// tail returns the last lines of a json-file log and its rotated files, within
+// the limits, formatted like docker logs --timestamps does. The files are read
+// backwards from their end, newest first, and only until the limits are met.
+// It also returns whether earlier lines were left out.
+func tail(path string, limits Logs) ([]string, bool, error) {
+	limits = limits.withDefaults()
+	t := &tailer{max: limits.Tail, budget: limits.MaxKB * 1024}
+	if limits.Since > 0 {
+		t.since = now().Add(-limits.Since)
+	}
+
+	for i := 0; !t.done; i++ {
+		err := readLog(rotated(path, i), t.add)
+		if os.IsNotExist(err) {
+			break
+		} else if err != nil {
+			return t.result(), t.truncated, err
+		}
+		// a line isn't put together across files
+		t.flush()
+		if len(t.lines) == t.max && !t.done {
+			// the cap is met, the next file only tells whether there is more
+			t.truncated = exists(rotated(path, i+1))
+			break
+		}
+	}
+	return t.result(), t.truncated, nil
+}
+
+// rotated returns the name of the i-th rotated file of a log, 0 being the
+// current file
+func rotated(path string, i int) string {
+	if i == 0 {
+		return path
+	}
+	return path + "." + strconv.Itoa(i)
+}
+
+func exists(name string) bool {
+	for _, n := range []string{name, name + ".gz"} {
+		if _, err := os.Stat(n); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// tailer gathers the lines of a log from the newest entry, putting back
+// together the lines the json-file driver splits in 16K entries, of which only
+// the last ends in a newline
+type tailer struct {
+	max       int
+	budget    int
+	since     time.Time
+	lines     []string  // newest first
+	size      int       // of lines
+	pending   []logLine // the entries of the line being put together
+	done      bool
+	truncated bool
+}
+
+// add takes the next entry, going back in the log, and returns false once no
+// more are needed
+func (t *tailer) add(l logLine) bool {
+	if strings.HasSuffix(l.Log, "\n") || len(t.pending) == 0 {
+		// l ends a line, so the line after it is complete
+		t.flush()
+	}
+	if !t.done {
+		t.pending = append([]logLine{l}, t.pending...)
+	}
+	return !t.done
+}
+
+// flush adds the line being put together, if it is within the limits
+func (t *tailer) flush() {
+	if len(t.pending) == 0 || t.done {
+		return
+	}
+	first := t.pending[0]
+	if first.Time.Before(t.since) {
+		t.done = true
+		return
+	}
+	line := format(t.pending)
+	t.pending = nil
+	if len(t.lines) == t.max || t.size+len(line) > t.budget {
+		t.done, t.truncated = true, true
+		return
+	}
+	t.lines = append(t.lines, line)
+	t.size += len(line)
+}
+
+// result returns the lines, oldest first
+func (t *tailer) result() []string {
+	lines := make([]string, len(t.lines))
+	for i, l := range t.lines {
+		lines[len(lines)-1-i] = l
+	}
+	return lines
+}
+
+// readLog calls add with the entries of a json-file log, newest first, until
+// it returns false. Rotated files gzipped with compress=true can't be read
+// backwards, they are parsed whole; their size is bounded by max-size.
+func readLog(name string, add func(logLine) bool) error {
+	f, err := os.Open(name)
+	if os.IsNotExist(err) {
+		if f, err = os.Open(name + ".gz"); err == nil {
+			defer f.Close()
+			gz, err := gzip.NewReader(f)
+			if err != nil {
+				return err
+			}
+			lines, err := parseLog(gz)
+			for i := len(lines) - 1; i >= 0; i-- {
+				if !add(lines[i]) {
+					break
+				}
+			}
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return readBackwards(f, fi.Size(), func(b []byte) bool {
+		var l logLine
+		if err := json.Unmarshal(b, &l); err != nil {
+			// e.g. a line being written
+			return true
+		}
+		return add(l)
+	})
+}
+
+const backwardsChunk = 64 * 1024
+
+// readBackwards calls fn with the lines of the first size bytes of r, from the
+// last, until it returns false
+func readBackwards(r io.ReaderAt, size int64, fn func([]byte) bool) error {
+	var rest []byte // the end of a line whose start is before end
+	for end := size; end > 0; {
+		start := end - backwardsChunk
+		if start < 0 {
+			start = 0
+		}
+		b := make([]byte, end-start, end-start+int64(len(rest)))
+		if _, err := r.ReadAt(b, start); err != nil && err != io.EOF {
+			return err
+		}
+		b = append(b, rest...)
+		end = start
+
+		lines := bytes.Split(b, []byte("\n"))
+		// unless at the start of the file, the first line goes on before b
+		first := 1
+		if start == 0 {
+			first = 0
+		}
+		for i := len(lines) - 1; i >= first; i-- {
+			if len(lines[i]) > 0 && !fn(lines[i]) {
+				return nil
+			}
+		}
+		rest = append([]byte(nil), lines[0]...)
+	}
+	return nil
+}
+
+func parseLog(r io.Reader) ([]logLine, error) {
+	var lines []logLine
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var l logLine
+		if err := json.Unmarshal(scanner.Bytes(), &l); err != nil {
+			// e.g. a line being written
+			continue
+		}
+		lines = append(lines, l)
+	}
+	return lines, scanner.Err()
+}
+
+// format returns a line from the entries it was split in, with the time and
+// stream of the first
+func format(entries []logLine) string {
+	var msg string
+	for _, l := range entries {
+		msg += l.Log
+	}
+	if !strings.HasSuffix(msg, "\n") {
+		msg += "\n"
+	}
+	return entries[0].Time.UTC().Format(time.RFC3339Nano) + " " + entries[0].Stream + " " + msg
+}
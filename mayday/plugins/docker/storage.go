@@ -0,0 +1,271 @@
+package docker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/coreos/mayday/mayday/plugins/systemd"
+	"github.com/coreos/mayday/mayday/scrub"
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+const (
+	defaultDaemonConfig = "/etc/docker/daemon.json"
+	defaultScanFiles    = 200000
+	defaultScanTime     = 20 * time.Second
+)
+
+// units are the systemd units of the docker daemon
+var units = []string{"docker.service", "docker.socket"}
+
+// GetHost collects what docker leaves on the host, whether or not the daemon
+// is running: its configuration, its systemd units, with their environment
+// scrubbed by env, and the disk usage of its data directory
+func GetHost(c Config, env *scrub.Scrubber) []*tarable.Output {
+	c = c.withDefaults()
+	var outputs []*tarable.Output
+
+	if b, err := ioutil.ReadFile(c.DaemonConfig); err == nil {
+		outputs = append(outputs, tarable.NewOutput(outputDir, "daemon.json", "", func() []byte { return scrubJSON(b) }))
+	} else if !os.IsNotExist(err) {
+		log.Printf("error reading docker daemon configuration: %s", err)
+	}
+
+	for _, u := range units {
+		if text := catUnit(u, env); text != "" {
+			outputs = append(outputs, tarable.NewOutput(outputDir, "systemd/"+u, "", func() []byte { return []byte(text) }))
+		}
+	}
+
+	outputs = append(outputs, tarable.NewOutput(outputDir, "disk-usage", "", func() []byte {
+		usage, err := diskUsage(c.Root, c.ScanFiles, c.ScanTime)
+		if err != nil {
+			return []byte(fmt.Sprintf("error reading %s: %s\n", c.Root, err))
+		}
+		return usageText(usage)
+	}))
+	return outputs
+}
+
+// catUnit returns a unit file followed by its drop-ins, like systemctl cat.
+// Environment settings are scrubbed by env.
+func catUnit(name string, env *scrub.Scrubber) string {
+	var b bytes.Buffer
+	for _, dir := range systemd.UnitDirs {
+		path := filepath.Join(dir, name)
+		if content, err := ioutil.ReadFile(path); err == nil {
+			fmt.Fprintf(&b, "# %s\n%s\n", path, systemd.RedactUnitFile(string(content), env))
+			break
+		}
+	}
+
+	// drop-ins with the same name override the ones in less important
+	// directories, and are applied in the order of their names
+	dropIns := make(map[string]string)
+	var names []string
+	for _, dir := range systemd.UnitDirs {
+		confs, _ := filepath.Glob(filepath.Join(dir, name+".d", "*.conf"))
+		for _, conf := range confs {
+			base := filepath.Base(conf)
+			if _, ok := dropIns[base]; !ok {
+				dropIns[base] = conf
+				names = append(names, base)
+			}
+		}
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		if content, err := ioutil.ReadFile(dropIns[n]); err == nil {
+			fmt.Fprintf(&b, "# %s\n%s\n", dropIns[n], systemd.RedactUnitFile(string(content), env))
+		}
+	}
+	return b.String()
+}
+
+// dirUsage is the disk space used by a directory of the data directory
+type dirUsage struct {
+	path     string
+	bytes    int64
+	files    int
+	scanned  bool
+	complete bool // false when the scan budget ran out
+}
+
+var errBudget = errors.New("scan budget exhausted")
+
+// diskUsage measures each directory of root, stopping once maxFiles files
+// have been looked at or maxTime has passed. Like du -x, it doesn't cross
+// into other filesystems, such as the merged directories of running overlay2
+// containers or their shm mounts.
+func diskUsage(root string, maxFiles int, maxTime time.Duration) ([]dirUsage, error) {
+	fi, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	dev := device(fi)
+	entries, err := ioutil.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	deadline := now().Add(maxTime)
+	budget := maxFiles
+
+	var usage []dirUsage
+	for _, e := range entries {
+		u := dirUsage{path: filepath.Join(root, e.Name())}
+		if !e.IsDir() {
+			u.bytes, u.files, u.scanned, u.complete = allocated(e), 1, true, true
+			usage = append(usage, u)
+			continue
+		}
+		if budget <= 0 {
+			usage = append(usage, u)
+			continue
+		}
+		u.scanned = true
+		err := filepath.Walk(u.path, func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				// e.g. removed during the scan
+				return nil
+			}
+			if fi.IsDir() && device(fi) != dev {
+				return filepath.SkipDir
+			}
+			if budget <= 0 || now().After(deadline) {
+				budget = 0
+				return errBudget
+			}
+			budget--
+			u.files++
+			u.bytes += allocated(fi)
+			return nil
+		})
+		u.complete = err == nil
+		usage = append(usage, u)
+	}
+	return usage, nil
+}
+
+func usageText(usage []dirUsage) []byte {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "SIZE\tFILES\tPATH")
+	var total int64
+	complete := true
+	for _, u := range usage {
+		total += u.bytes
+		switch {
+		case !u.scanned:
+			complete = false
+			fmt.Fprintf(w, "-\t-\t%s (not scanned, scan budget exhausted)\n", u.path)
+		case !u.complete:
+			complete = false
+			fmt.Fprintf(w, ">%s\t>%d\t%s (scan budget exhausted)\n", humanSize(u.bytes), u.files, u.path)
+		default:
+			fmt.Fprintf(w, "%s\t%d\t%s\n", humanSize(u.bytes), u.files, u.path)
+		}
+	}
+	if complete {
+		fmt.Fprintf(w, "%s\t\ttotal\n", humanSize(total))
+	} else {
+		fmt.Fprintf(w, ">%s\t\ttotal\n", humanSize(total))
+	}
+	w.Flush()
+	return b.Bytes()
+}
+
+func humanSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// fsUsage is the usage of a filesystem, in bytes
+type fsUsage struct {
+	Size       uint64
+	Free       uint64
+	Available  uint64
+	Inodes     uint64
+	InodesFree uint64
+}
+
+// storage reports the storage driver's status, the usage of the filesystem
+// holding the data directory, and the dangling images and volumes
+func storage(client *Client, info []byte, root string) []byte {
+	var i struct {
+		Driver        string
+		DriverStatus  [][]string
+		DockerRootDir string
+	}
+	json.Unmarshal(info, &i)
+	if i.DockerRootDir != "" {
+		root = i.DockerRootDir
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Storage driver: %s\n", i.Driver)
+	for _, s := range i.DriverStatus {
+		if len(s) == 2 {
+			fmt.Fprintf(&b, "  %s: %s\n", s[0], s[1])
+		}
+	}
+
+	fmt.Fprintf(&b, "\nFilesystem of %s:\n", root)
+	if fs, err := statfs(root); err != nil {
+		fmt.Fprintf(&b, "  error: %s\n", err)
+	} else {
+		used := fs.Size - fs.Free
+		fmt.Fprintf(&b, "  Size: %s\n", humanSize(int64(fs.Size)))
+		fmt.Fprintf(&b, "  Used: %s (%s)\n", humanSize(int64(used)), percent(used, fs.Size))
+		fmt.Fprintf(&b, "  Available: %s\n", humanSize(int64(fs.Available)))
+		fmt.Fprintf(&b, "  Inodes used: %d of %d (%s)\n", fs.Inodes-fs.InodesFree, fs.Inodes, percent(fs.Inodes-fs.InodesFree, fs.Inodes))
+	}
+
+	dangling := "?filters=" + url.QueryEscape(`{"dangling":["true"]}`)
+	b.WriteString("\n")
+	var images []struct{ Size int64 }
+	if body, err := client.get("/images/json" + dangling); err != nil {
+		fmt.Fprintf(&b, "Dangling images: %s\n", err)
+	} else if err := json.Unmarshal(body, &images); err != nil {
+		fmt.Fprintf(&b, "Dangling images: %s\n", err)
+	} else {
+		var size int64
+		for _, img := range images {
+			size += img.Size
+		}
+		fmt.Fprintf(&b, "Dangling images: %d (%s)\n", len(images), humanSize(size))
+	}
+	var volumes struct{ Volumes []json.RawMessage }
+	if body, err := client.get("/volumes" + dangling); err != nil {
+		fmt.Fprintf(&b, "Dangling volumes: %s\n", err)
+	} else if err := json.Unmarshal(body, &volumes); err != nil {
+		fmt.Fprintf(&b, "Dangling volumes: %s\n", err)
+	} else {
+		fmt.Fprintf(&b, "Dangling volumes: %d\n", len(volumes.Volumes))
+	}
+	return b.Bytes()
+}
+
+func percent(n, total uint64) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d%%", n*100/total)
+}
@@ -0,0 +1,39 @@
+package docker
+
+import (
+	"os"
+	"syscall"
+)
+
+func statfs(path string) (*fsUsage, error) {
+	var s syscall.Statfs_t
+	if err := syscall.Statfs(path, &s); err != nil {
+		return nil, err
+	}
+	bs := uint64(s.Bsize)
+	return &fsUsage{
+		Size:       s.Blocks * bs,
+		Free:       s.Bfree * bs,
+		Available:  s.Bavail * bs,
+		Inodes:     s.Files,
+		InodesFree: s.Ffree,
+	}, nil
+}
+
+// allocated returns the disk space used by a file, which is less than its
+// size when it's sparse
+func allocated(fi os.FileInfo) int64 {
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return st.Blocks * 512
+	}
+	return fi.Size()
+}
+
+// device returns the device holding a file, so that scans stay on one
+// filesystem
+var device = func(fi os.FileInfo) uint64 {
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return uint64(st.Dev)
+	}
+	return 0
+}
@@ -0,0 +1,21 @@
+//go:build !linux
+// +build !linux
+
+package docker
+
+import (
+	"errors"
+	"os"
+)
+
+func statfs(path string) (*fsUsage, error) {
+	return nil, errors.New("filesystem usage is only supported on linux")
+}
+
+func allocated(fi os.FileInfo) int64 {
+	return fi.Size()
+}
+
+var device = func(fi os.FileInfo) uint64 {
+	return 0
+}
@@ -1 +1,119 @@
 package file
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "mayday-file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestCollectRegularFile(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "os-release")
+	ioutil.WriteFile(name, []byte("ID=coreos\n"), 0644)
+
+	tarables, r, err := Collect(name, "os-release")
+	assert.Nil(t, err)
+	assert.False(t, r.IsLink())
+	assert.Len(t, tarables, 1)
+	assert.Equal(t, tarables[0].Name(), name)
+	assert.Equal(t, tarables[0].Link(), "os-release")
+	assert.Equal(t, tarables[0].Content().String(), "ID=coreos\n")
+}
+
+func TestCollectSymlinkChain(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	target := filepath.Join(dir, "run", "resolv.conf")
+	os.MkdirAll(filepath.Dir(target), 0755)
+	ioutil.WriteFile(target, []byte("nameserver 127.0.0.53\n"), 0644)
+
+	middle := filepath.Join(dir, "stub-resolv.conf")
+	os.Symlink("run/resolv.conf", middle)
+	name := filepath.Join(dir, "etc", "resolv.conf")
+	os.MkdirAll(filepath.Dir(name), 0755)
+	os.Symlink(middle, name)
+
+	tarables, r, err := Collect(name, "resolv")
+	assert.Nil(t, err)
+	assert.Equal(t, r.Chain, []string{name, middle, target})
+	assert.Len(t, tarables, 3)
+
+	hdr := tarables[0].Header()
+	assert.Equal(t, int(hdr.Typeflag), int(tar.TypeSymlink))
+	assert.Equal(t, hdr.Name, name)
+	assert.Equal(t, hdr.Linkname, "../stub-resolv.conf")
+	assert.Equal(t, tarables[0].Link(), "resolv")
+
+	assert.Equal(t, tarables[1].Header().Linkname, "run/resolv.conf")
+	assert.Equal(t, tarables[1].Link(), "")
+
+	assert.Equal(t, tarables[2].Name(), target)
+	assert.Equal(t, tarables[2].Content().String(), "nameserver 127.0.0.53\n")
+}
+
+func TestCollectDanglingSymlink(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "resolv.conf")
+	os.Symlink(filepath.Join(dir, "missing"), name)
+
+	tarables, r, err := Collect(name, "")
+	assert.Nil(t, err)
+	assert.True(t, r.Dangling)
+	assert.Len(t, tarables, 1)
+	assert.Contains(t, r.String(), "(dangling)")
+}
+
+func TestCollectSymlinkLoop(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	a := filepath.Join(dir, "a")
+	b := filepath.Join(dir, "b")
+	os.Symlink(b, a)
+	os.Symlink(a, b)
+
+	tarables, r, err := Collect(a, "")
+	assert.Nil(t, err)
+	assert.True(t, r.Loop)
+	assert.Equal(t, r.Chain, []string{a, b, a})
+	assert.Len(t, tarables, 2)
+}
+
+func TestSensitiveTargetNotCollected(t *testing.T) {
+	assert.False(t, allowed("/etc/shadow"))
+	assert.False(t, allowed("/etc/ssl/private/server.key"))
+	assert.False(t, allowed("/root/.bash_history"))
+}
+
+func TestReport(t *testing.T) {
+	resolutions := []*Resolution{
+		{Chain: []string{"/etc/os-release", "/usr/lib/os-release"}},
+		{Chain: []string{"/proc/meminfo"}},
+		{Chain: []string{"/etc/resolv.conf", "/run/missing"}, Dangling: true},
+	}
+
+	content := new(bytes.Buffer)
+	content.ReadFrom(NewReport(resolutions).Content())
+
+	assert.Equal(t, content.String(),
+		"/etc/os-release -> /usr/lib/os-release\n"+
+			"/etc/resolv.conf -> /run/missing (dangling)\n")
+}
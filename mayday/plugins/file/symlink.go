@@ -0,0 +1,252 @@
+package file
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+const (
+	// maxLinkDepth matches the kernel's limit on nested symlinks
+	maxLinkDepth = 40
+)
+
+// sensitivePaths are never collected when a symlink points into them, even
+// though the link itself is recorded.
+var sensitivePaths = []string{
+	"/etc/shadow",
+	"/etc/gshadow",
+	"/etc/ssh",
+	"/etc/ssl/private",
+	"/root",
+	"/home",
+}
+
+// Resolution describes how a configured path resolves through symlinks
+type Resolution struct {
+	Chain    []string // every hop, starting with the configured path
+	Dangling bool     // the last hop does not exist
+	Loop     bool     // the chain revisits a hop or is too deep to follow
+}
+
+// Target returns the final path in the chain
+func (r *Resolution) Target() string {
+	return r.Chain[len(r.Chain)-1]
+}
+
+// IsLink reports whether the configured path was a symlink
+func (r *Resolution) IsLink() bool {
+	return len(r.Chain) > 1
+}
+
+func (r *Resolution) String() string {
+	s := strings.Join(r.Chain, " -> ")
+	if r.Dangling {
+		s += " (dangling)"
+	}
+	if r.Loop {
+		s += " (loop)"
+	}
+	return s
+}
+
+// Resolve follows name one symlink at a time, recording each hop
+func Resolve(name string) (*Resolution, error) {
+	r := &Resolution{Chain: []string{name}}
+	seen := map[string]bool{name: true}
+
+	cur := name
+	for {
+		fi, err := os.Lstat(cur)
+		if err != nil {
+			if os.IsNotExist(err) && r.IsLink() {
+				r.Dangling = true
+				return r, nil
+			}
+			return nil, err
+		}
+		if fi.Mode()&os.ModeSymlink == 0 {
+			return r, nil
+		}
+
+		target, err := os.Readlink(cur)
+		if err != nil {
+			return nil, err
+		}
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(cur), target)
+		}
+		target = filepath.Clean(target)
+
+		r.Chain = append(r.Chain, target)
+		if seen[target] || len(r.Chain) > maxLinkDepth {
+			r.Loop = true
+			return r, nil
+		}
+		seen[target] = true
+		cur = target
+	}
+}
+
+// allowed reports whether a symlink target may be collected
+func allowed(name string) bool {
+	for _, p := range sensitivePaths {
+		if name == p || strings.HasPrefix(name, p+"/") {
+			return false
+		}
+	}
+	fi, err := os.Stat(name)
+	if err != nil {
+		return false
+	}
+	return fi.Mode().IsRegular()
+}
+
+// Open opens a regular file for collection
+func Open(name string, link string) (*MaydayFile, error) {
+	content, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := content.Stat()
+	if err != nil {
+		content.Close()
+		return nil, err
+	}
+
+	header, err := tar.FileInfoHeader(fi, name)
+	if err != nil {
+		content.Close()
+		return nil, err
+	}
+	header.Name = name
+
+	return New(content, header, name, link), nil
+}
+
+// Collect returns everything needed to represent name in the archive. A
+// symlink is stored as a symlink (as is every further hop), and the final
+// target is collected too when it is a regular file outside sensitivePaths.
+func Collect(name string, link string) ([]tarable.Tarable, *Resolution, error) {
+	r, err := Resolve(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !r.IsLink() {
+		f, err := Open(name, link)
+		if err != nil {
+			return nil, r, err
+		}
+		return []tarable.Tarable{f}, r, nil
+	}
+
+	var tarables []tarable.Tarable
+	for i := 0; i < len(r.Chain)-1; i++ {
+		l := ""
+		if i == 0 {
+			l = link
+		}
+		tarables = append(tarables, NewSymlink(r.Chain[i], r.Chain[i+1], l))
+	}
+
+	if r.Dangling || r.Loop {
+		log.Printf("not collecting target of %s", r)
+		return tarables, r, nil
+	}
+
+	target := r.Target()
+	if !allowed(target) {
+		log.Printf("not collecting %q: target is not an allowed regular file", target)
+		return tarables, r, nil
+	}
+
+	f, err := Open(target, "")
+	if err != nil {
+		return tarables, r, err
+	}
+	return append(tarables, f), r, nil
+}
+
+// Symlink is a symbolic link stored as a link in the archive
+type Symlink struct {
+	name   string // path of the link on the filesystem
+	target string // absolute path the link points to
+	link   string // a link to make in the root of the tarball
+}
+
+func NewSymlink(name string, target string, link string) *Symlink {
+	return &Symlink{name: name, target: target, link: link}
+}
+
+func (s *Symlink) Content() *bytes.Buffer {
+	return new(bytes.Buffer)
+}
+
+func (s *Symlink) Header() *tar.Header {
+	// the archive mirrors the filesystem, so a relative link stays valid
+	// once extracted
+	linkname, err := filepath.Rel(filepath.Dir(s.name), s.target)
+	if err != nil {
+		linkname = s.target
+	}
+
+	var header tar.Header
+	header.Name = s.name
+	header.Linkname = linkname
+	header.Typeflag = tar.TypeSymlink
+	header.Mode = 0777
+	header.ModTime = time.Now()
+
+	return &header
+}
+
+func (s *Symlink) Name() string {
+	return s.name
+}
+
+func (s *Symlink) Link() string {
+	return s.link
+}
+
+// Report lists the resolution chain of every configured symlink
+type Report struct {
+	resolutions []*Resolution
+	content     *bytes.Buffer
+}
+
+func NewReport(resolutions []*Resolution) *Report {
+	return &Report{resolutions: resolutions}
+}
+
+func (r *Report) Content() *bytes.Buffer {
+	if r.content == nil {
+		r.content = new(bytes.Buffer)
+		for _, res := range r.resolutions {
+			if res.IsLink() {
+				fmt.Fprintln(r.content, res)
+			}
+		}
+	}
+	return r.content
+}
+
+func (r *Report) Header() *tar.Header {
+	return tarable.Header(r.Content(), r.Name())
+}
+
+func (r *Report) Name() string {
+	return "/mayday_symlinks"
+}
+
+func (r *Report) Link() string {
+	return ""
+}
@@ -0,0 +1,123 @@
+package journal
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// kernelName is the name of the kernel log, which isn't a unit
+	kernelName = "kernel"
+)
+
+// Rule matches units by regular expressions on their name, the path of their
+// unit file and their type ("service", "socket", "timer", ...). Every
+// expression that is set must match.
+type Rule struct {
+	Name string `mapstructure:"name"`
+	Path string `mapstructure:"path"`
+	Type string `mapstructure:"type"`
+}
+
+// Discovery selects the units whose journals are collected
+type Discovery struct {
+	Include []Rule `mapstructure:"include"` // default: every service
+	Exclude []Rule `mapstructure:"exclude"`
+	Failed  *bool  `mapstructure:"failed"` // collect failed units even if not included (default: true)
+	Kernel  *bool  `mapstructure:"kernel"` // collect the kernel log (default: true)
+}
+
+var defaultInclude = []Rule{{Type: "^service$"}}
+
+// enabled returns the value of an option that defaults to true
+func enabled(b *bool) bool {
+	return b == nil || *b
+}
+
+type rule struct {
+	name, path, typ *regexp.Regexp
+}
+
+func compile(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(expr)
+}
+
+func compileRules(rules []Rule) ([]rule, error) {
+	var compiled []rule
+	for _, r := range rules {
+		var c rule
+		var err error
+		if c.name, err = compile(r.Name); err != nil {
+			return nil, fmt.Errorf("bad unit name rule: %v", err)
+		}
+		if c.path, err = compile(r.Path); err != nil {
+			return nil, fmt.Errorf("bad unit path rule: %v", err)
+		}
+		if c.typ, err = compile(r.Type); err != nil {
+			return nil, fmt.Errorf("bad unit type rule: %v", err)
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// unitType returns the type of a unit from the suffix of its name, or of its
+// unit file
+func unitType(name, path string) string {
+	for _, s := range []string{name, path} {
+		if i := strings.LastIndex(s, "."); i >= 0 && !strings.Contains(s[i:], "/") {
+			return s[i+1:]
+		}
+	}
+	return ""
+}
+
+func (r rule) match(name, path string) bool {
+	return (r.name == nil || r.name.MatchString(name)) &&
+		(r.path == nil || r.path.MatchString(path)) &&
+		(r.typ == nil || r.typ.MatchString(unitType(name, path)))
+}
+
+func matchAny(rules []rule, name, path string) bool {
+	for _, r := range rules {
+		if r.match(name, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// selector decides which units are collected
+type selector struct {
+	include, exclude []rule
+	failed           bool
+}
+
+func newSelector(d Discovery) (*selector, error) {
+	include := d.Include
+	if len(include) == 0 {
+		include = defaultInclude
+	}
+	s := &selector{failed: enabled(d.Failed)}
+	var err error
+	if s.include, err = compileRules(include); err != nil {
+		return nil, err
+	}
+	if s.exclude, err = compileRules(d.Exclude); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *selector) selected(st dbusStatus) bool {
+	name := st.unit.Name
+	path, _ := st.property.Value.Value().(string)
+	if s.failed && st.unit.ActiveState == "failed" {
+		return true
+	}
+	return matchAny(s.include, name, path) && !matchAny(s.exclude, name, path)
+}
@@ -2,21 +2,132 @@ package journal
 
 import (
 	"archive/tar"
-	"bufio"
 	"bytes"
-	"fmt"
 	"log"
 	"os/exec"
-	"regexp"
+	"strconv"
 
 	"github.com/coreos/go-systemd/dbus"
 	"github.com/coreos/mayday/mayday/tarable"
 )
 
+const (
+	defaultSince = "-7d"
+)
+
+// Options select which part of a unit's journal is collected
+type Options struct {
+	Since    string `mapstructure:"since"`    // absolute ("2017-01-02 15:04:05") or relative ("-2d") start
+	Until    string `mapstructure:"until"`    // absolute or relative end
+	Priority string `mapstructure:"priority"` // minimum priority, e.g. "warning" or "4"
+	Boot     string `mapstructure:"boot"`     // "current", "previous", "all" (default) or a boot ID/offset
+	Lines    int    `mapstructure:"lines"`    // only the last N lines
+	Output   string `mapstructure:"output"`   // journalctl output mode, e.g. "short-iso", "json" or "export"
+}
+
+// Merge returns o with every field that is set in override replaced
+func (o Options) Merge(override Options) Options {
+	if override.Since != "" {
+		o.Since = override.Since
+	}
+	if override.Until != "" {
+		o.Until = override.Until
+	}
+	if override.Priority != "" {
+		o.Priority = override.Priority
+	}
+	if override.Boot != "" {
+		o.Boot = override.Boot
+	}
+	if override.Lines != 0 {
+		o.Lines = override.Lines
+	}
+	if override.Output != "" {
+		o.Output = override.Output
+	}
+	return o
+}
+
+// args returns the journalctl arguments selecting what o describes
+func (o Options) args() []string {
+	var args []string
+	if o.Since != "" {
+		args = append(args, "--since", o.Since)
+	}
+	if o.Until != "" {
+		args = append(args, "--until", o.Until)
+	}
+	if o.Priority != "" {
+		args = append(args, "--priority", o.Priority)
+	}
+	switch o.Boot {
+	case "", "all":
+	case "current":
+		args = append(args, "--boot")
+	case "previous":
+		args = append(args, "--boot", "-1")
+	default:
+		args = append(args, "--boot", o.Boot)
+	}
+	if o.Lines > 0 {
+		args = append(args, "--lines", strconv.Itoa(o.Lines))
+	}
+	if o.Output != "" {
+		args = append(args, "--output", o.Output)
+	}
+	return args
+}
+
+// extension returns the file extension matching the output mode
+func (o Options) extension() string {
+	switch o.Output {
+	case "json", "json-pretty", "json-sse":
+		return ".json"
+	case "export":
+		return ".export"
+	}
+	return ".log"
+}
+
+// Config is the journal section of the configuration file: options for
+// every unit, and overrides for specific units
+type Config struct {
+	Options   `mapstructure:",squash"`
+	Discovery `mapstructure:",squash"`
+	Units     []UnitOptions `mapstructure:"units"`
+	Directory string        `mapstructure:"directory"` // read the journal files here instead of the local journal
+}
+
+// UnitOptions override the journal options for one unit
+type UnitOptions struct {
+	Name    string `mapstructure:"name"`
+	Options `mapstructure:",squash"`
+}
+
+// options returns the options for a unit, or for the kernel log
+func (c Config) options(unit string) Options {
+	o := Options{Since: defaultSince}
+	if unit == kernelName {
+		// like journalctl -k, which implies -b
+		o.Boot = "current"
+	}
+	o = o.Merge(c.Options)
+	for _, u := range c.Units {
+		if u.Name == unit {
+			o = o.Merge(u.Options)
+		}
+	}
+	return o
+}
+
 type SystemdJournal struct {
 	name    string
 	link    string        // currently never set to anything
 	content *bytes.Buffer // the contents of the log, populated by Run()
+	options Options       // which part of the journal to collect
+	kernel  bool          // the kernel log rather than a unit's
+	dir     string        // journal directory, empty for the local journal
+	reader  *Reader       // reads the journal directly, nil to use journalctl
 }
 
 type dbusStatus struct {
@@ -48,7 +159,7 @@ var getJournals = func() ([]dbusStatus, error) {
 	return statuses, nil
 }
 
-func List() ([]*SystemdJournal, error) {
+func List(c Config) ([]*SystemdJournal, error) {
 	var svcs []*SystemdJournal
 
 	statuses, err := getJournals()
@@ -56,20 +167,35 @@ func List() ([]*SystemdJournal, error) {
 		return svcs, err
 	}
 
-	pathre := regexp.MustCompile(`/usr/lib(32|64)?/systemd/system/.*\.service`)
+	sel, err := newSelector(c.Discovery)
+	if err != nil {
+		return svcs, err
+	}
 
-	// build a list of units that live in /usr/lib/system/system
 	for _, s := range statuses {
-		path := s.property.Value.Value().(string)
-		if pathre.MatchString(path) {
-			svc := SystemdJournal{name: s.unit.Name}
+		if sel.selected(s) {
+			svc := SystemdJournal{name: s.unit.Name, options: c.options(s.unit.Name), dir: c.Directory}
 			svcs = append(svcs, &svc)
 		}
 	}
+
+	if enabled(c.Kernel) {
+		svcs = append(svcs, &SystemdJournal{name: kernelName, kernel: true, options: c.options(kernelName), dir: c.Directory})
+	}
+
+	if openJournal != nil {
+		r := &Reader{dir: c.Directory, journals: svcs}
+		for _, svc := range svcs {
+			svc.reader = r
+		}
+	}
 	return svcs, nil
 }
 
 func (j *SystemdJournal) Content() *bytes.Buffer {
+	if j.content == nil && j.reader != nil && j.reader.read() == nil {
+		j.content = j.reader.content(j)
+	}
 	if j.content == nil {
 		j.Run()
 	}
@@ -77,7 +203,7 @@ func (j *SystemdJournal) Content() *bytes.Buffer {
 }
 
 func (j *SystemdJournal) Name() string {
-	return "/journals/" + j.name + ".log"
+	return "/journals/" + j.name + j.options.extension()
 }
 
 func (j *SystemdJournal) Header() *tar.Header {
@@ -91,19 +217,25 @@ func (j *SystemdJournal) Link() string {
 func (j *SystemdJournal) Run() error {
 	var b bytes.Buffer
 	j.content = &b
-	writer := bufio.NewWriter(j.content)
-
-	daysago := 7
 
-	log.Printf("collecting %d days of logs from %q", daysago, j.name)
+	log.Printf("collecting logs from %q", j.name)
 
-	cmd := exec.Command("journalctl", "--since", fmt.Sprintf("-%dd", daysago), "-l", "--utc", "--no-pager", "-u", j.name)
-	cmd.Stdout = writer
+	args := append(j.options.args(), "-l", "--utc", "--no-pager")
+	if j.kernel {
+		args = append(args, "--dmesg")
+	} else {
+		args = append(args, "-u", j.name)
+	}
+	if j.dir != "" {
+		args = append(args, "--directory", j.dir)
+	}
+	cmd := exec.Command("journalctl", args...)
+	cmd.Stdout = j.content
 
 	err := cmd.Run()
 
 	if err != nil {
-		log.Printf("failed to dump log for %s: %s", j.Name, err)
+		log.Printf("failed to dump log for %s: %s", j.name, err)
 	}
 
 	return err
@@ -2,7 +2,9 @@ package journal
 
 import (
 	"bytes"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/coreos/go-systemd/dbus"
 	godbus "github.com/godbus/dbus"
@@ -25,24 +27,288 @@ func TestListJournals(t *testing.T) {
 		statuses := []dbusStatus{
 			{
 				unit: dbus.UnitStatus{Name: "testd"},
-				property: &dbus.Property{"testd",
-					godbus.MakeVariant("/usr/lib64/systemd/system/testd.service")}},
+				property: &dbus.Property{Name: "testd",
+					Value: godbus.MakeVariant("/usr/lib64/systemd/system/testd.service")}},
 			{
 				unit: dbus.UnitStatus{Name: "examd"},
-				property: &dbus.Property{"examd",
-					godbus.MakeVariant("/usr/lib64/systemd/system/examd.service")}},
+				property: &dbus.Property{Name: "examd",
+					Value: godbus.MakeVariant("/usr/lib64/systemd/system/examd.service")}},
 			{
 				unit: dbus.UnitStatus{Name: "notaservice"},
-				property: &dbus.Property{"notaservice",
-					godbus.MakeVariant("/usr/lib/systemd/system/umount.target")},
+				property: &dbus.Property{Name: "notaservice",
+					Value: godbus.MakeVariant("/usr/lib/systemd/system/umount.target")},
 			}}
 
 		return statuses, nil
 	}
 
-	journals, err := List()
+	journals, err := List(Config{})
 	assert.Nil(t, err)
-	assert.Len(t, journals, 2)
+	assert.Len(t, journals, 3)
 	assert.Equal(t, journals[0].Name(), "/journals/testd.log")
 	assert.Equal(t, journals[1].Name(), "/journals/examd.log")
+	assert.Equal(t, journals[2].Name(), "/journals/kernel.log")
+	assert.True(t, journals[2].kernel)
+}
+
+func TestDiscovery(t *testing.T) {
+	status := func(name, state, path string) dbusStatus {
+		return dbusStatus{
+			unit:     dbus.UnitStatus{Name: name, ActiveState: state},
+			property: &dbus.Property{Name: "FragmentPath", Value: godbus.MakeVariant(path)},
+		}
+	}
+	getJournals = func() ([]dbusStatus, error) {
+		return []dbusStatus{
+			status("etcd.service", "active", "/usr/lib/systemd/system/etcd.service"),
+			status("custom.service", "active", "/etc/systemd/system/custom.service"),
+			status("run-r1234.service", "active", "/run/systemd/transient/run-r1234.service"),
+			status("docker.socket", "active", "/usr/lib/systemd/system/docker.socket"),
+			status("update-engine.timer", "failed", "/usr/lib/systemd/system/update-engine.timer"),
+			status("var-lib-docker.mount", "active", "/run/systemd/generator/var-lib-docker.mount"),
+		}, nil
+	}
+	names := func(c Config) []string {
+		journals, err := List(c)
+		assert.Nil(t, err)
+		var ns []string
+		for _, j := range journals {
+			ns = append(ns, j.name)
+		}
+		return ns
+	}
+
+	// every service, wherever it's defined, failed units and the kernel log
+	assert.Equal(t, names(Config{}), []string{
+		"etcd.service", "custom.service", "run-r1234.service", "update-engine.timer", "kernel",
+	})
+
+	no := false
+	assert.Equal(t, names(Config{Discovery: Discovery{
+		Include: []Rule{{Type: "^(service|socket)$"}, {Path: "^/run/systemd/generator/"}},
+		Exclude: []Rule{{Name: "^run-"}, {Type: "socket", Path: "^/usr/lib/"}},
+		Failed:  &no,
+		Kernel:  &no,
+	}}), []string{"etcd.service", "custom.service", "var-lib-docker.mount"})
+
+	_, err := List(Config{Discovery: Discovery{Exclude: []Rule{{Name: "("}}}})
+	assert.NotNil(t, err)
+}
+
+func TestKernel(t *testing.T) {
+	j := &SystemdJournal{name: kernelName, kernel: true, options: Config{}.options(kernelName)}
+	assert.Equal(t, j.options.args(), []string{"--since", "-7d", "--boot"})
+
+	// the native reader splits kernel messages out by transport
+	now := time.Now()
+	fake := &fakeJournal{entries: []*Entry{
+		entry(now, bootA, "_TRANSPORT", "kernel", "MESSAGE", "oops"),
+		entry(now, bootA, "_TRANSPORT", "journal", "_SYSTEMD_UNIT", "etcd.service", "MESSAGE", "hello"),
+	}}
+	openJournal = func(dir string) (journalFiles, error) { return fake, nil }
+	currentBoot = func() (string, error) { return bootA, nil }
+	defer func() { openJournal = nil }()
+
+	j.options.Output = "cat"
+	r := &Reader{journals: []*SystemdJournal{j}}
+	assert.Nil(t, r.split(now))
+	assert.Equal(t, r.content(j).String(), "oops\n")
+}
+
+func TestOptions(t *testing.T) {
+	c := Config{
+		Options: Options{Priority: "err", Boot: "current"},
+		Units: []UnitOptions{
+			{Name: "docker.service", Options: Options{Since: "2017-01-02 15:04:05", Boot: "previous", Lines: 100, Output: "json"}},
+		},
+	}
+
+	o := c.options("etcd.service")
+	assert.Equal(t, o, Options{Since: "-7d", Priority: "err", Boot: "current"})
+	assert.Equal(t, o.args(), []string{"--since", "-7d", "--priority", "err", "--boot"})
+
+	o = c.options("docker.service")
+	assert.Equal(t, o.args(), []string{
+		"--since", "2017-01-02 15:04:05", "--priority", "err", "--boot", "-1", "--lines", "100", "--output", "json",
+	})
+
+	assert.Empty(t, Options{Boot: "all"}.args())
+	assert.Equal(t, Options{Boot: "-2"}.args(), []string{"--boot", "-2"})
+	assert.Equal(t, Options{Until: "-1h"}.args(), []string{"--until", "-1h"})
+
+	jnl := SystemdJournal{name: "docker.service", options: o}
+	assert.Equal(t, jnl.Name(), "/journals/docker.service.json")
+	jnl.options.Output = "export"
+	assert.Equal(t, jnl.Name(), "/journals/docker.service.export")
+}
+
+// fakeJournal serves entries from memory in place of the journal files
+type fakeJournal struct {
+	entries []*Entry
+	seeked  time.Time
+	closed  bool
+}
+
+func (f *fakeJournal) SeekRealtime(t time.Time) error {
+	f.seeked = t
+	return nil
+}
+
+func (f *fakeJournal) Next() (*Entry, error) {
+	if len(f.entries) == 0 {
+		return nil, nil
+	}
+	e := f.entries[0]
+	f.entries = f.entries[1:]
+	return e, nil
+}
+
+func (f *fakeJournal) Close() error {
+	f.closed = true
+	return nil
+}
+
+const (
+	bootA = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	bootB = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func entry(t time.Time, boot string, fields ...string) *Entry {
+	e := &Entry{
+		Fields:   map[string]string{"_BOOT_ID": boot, "_HOSTNAME": "node1"},
+		Realtime: uint64(t.UnixNano() / int64(time.Microsecond)),
+	}
+	for i := 0; i+1 < len(fields); i += 2 {
+		e.Fields[fields[i]] = fields[i+1]
+	}
+	return e
+}
+
+func TestReader(t *testing.T) {
+	now := time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	fake := &fakeJournal{entries: []*Entry{
+		entry(now.Add(-48*time.Hour), bootA, "_SYSTEMD_UNIT", "etcd.service", "SYSLOG_IDENTIFIER", "etcd", "_PID", "700", "PRIORITY", "6", "MESSAGE", "old boot"),
+		entry(now.Add(-2*time.Hour), bootB, "_SYSTEMD_UNIT", "init.scope", "_PID", "1", "UNIT", "etcd.service", "SYSLOG_IDENTIFIER", "systemd", "PRIORITY", "6", "MESSAGE", "Started etcd."),
+		entry(now.Add(-time.Hour), bootB, "_SYSTEMD_UNIT", "etcd.service", "_COMM", "etcd", "_PID", "800", "PRIORITY", "3", "MESSAGE", "line one\nline two"),
+		entry(now.Add(-time.Minute), bootB, "_SYSTEMD_UNIT", "docker.service", "SYSLOG_IDENTIFIER", "dockerd", "_PID", "900", "PRIORITY", "4", "MESSAGE", "warning"),
+		entry(now.Add(-time.Second), bootB, "_SYSTEMD_UNIT", "docker.service", "SYSLOG_IDENTIFIER", "dockerd", "_PID", "900", "PRIORITY", "6", "MESSAGE", "info"),
+	}}
+	openJournal = func(dir string) (journalFiles, error) {
+		assert.Equal(t, dir, "/host/var/log/journal")
+		return fake, nil
+	}
+	currentBoot = func() (string, error) { return bootB, nil }
+	defer func() { openJournal = nil }()
+
+	etcd := &SystemdJournal{name: "etcd.service", options: Options{Since: "-3d", Output: "short-iso"}}
+	previous := &SystemdJournal{name: "etcd.service", options: Options{Since: "-3d", Boot: "previous", Output: "cat"}}
+	docker := &SystemdJournal{name: "docker.service", options: Options{Since: "-1d", Priority: "warning", Output: "cat"}}
+	r := &Reader{dir: "/host/var/log/journal", journals: []*SystemdJournal{etcd, previous, docker}}
+	assert.Nil(t, r.split(now))
+
+	// the journal is read from the earliest start of any unit
+	assert.Equal(t, fake.seeked, now.Add(-72*time.Hour))
+	assert.True(t, fake.closed)
+
+	assert.Equal(t, r.content(etcd).String(), strings.Join([]string{
+		"2017-02-27T12:00:00+0000 node1 etcd[700]: old boot",
+		"2017-03-01T10:00:00+0000 node1 systemd[1]: Started etcd.",
+		"2017-03-01T11:00:00+0000 node1 etcd[800]: line one",
+		strings.Repeat(" ", len("2017-03-01T11:00:00+0000 node1 etcd[800]: ")) + "line two",
+		"",
+	}, "\n"))
+	assert.Equal(t, r.content(previous).String(), "old boot\n")
+	assert.Equal(t, r.content(docker).String(), "warning\n")
+	// each log is collected once, and the spool is closed after the last
+	assert.Nil(t, r.content(etcd))
+	assert.NotNil(t, r.spool.Close())
+}
+
+func TestReaderFallback(t *testing.T) {
+	opened := false
+	openJournal = func(dir string) (journalFiles, error) {
+		opened = true
+		return &fakeJournal{}, nil
+	}
+	defer func() { openJournal = nil }()
+
+	// verbose output isn't implemented natively, so journalctl has to be used
+	r := &Reader{journals: []*SystemdJournal{{name: "etcd.service", options: Options{Output: "verbose"}}}}
+	assert.NotNil(t, r.read())
+	assert.False(t, opened)
+	assert.Nil(t, r.journals[0].content)
+}
+
+func TestReaderLines(t *testing.T) {
+	now := time.Now()
+	fake := &fakeJournal{}
+	for i := 0; i < 10; i++ {
+		fake.entries = append(fake.entries, entry(now, bootA, "_SYSTEMD_UNIT", "etcd.service", "MESSAGE", string('0'+rune(i))))
+	}
+	openJournal = func(dir string) (journalFiles, error) { return fake, nil }
+	defer func() { openJournal = nil }()
+
+	j := &SystemdJournal{name: "etcd.service", options: Options{Lines: 3, Output: "cat"}}
+	r := &Reader{journals: []*SystemdJournal{j}}
+	assert.Nil(t, r.split(now))
+	assert.Equal(t, r.content(j).String(), "7\n8\n9\n")
+	// no unit has a start, so the whole journal is read
+	assert.True(t, fake.seeked.IsZero())
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2017, 3, 1, 12, 30, 0, 0, time.UTC)
+	for spec, want := range map[string]time.Time{
+		"now":                     now,
+		"today":                   time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC),
+		"yesterday":               time.Date(2017, 2, 28, 0, 0, 0, 0, time.UTC),
+		"-7d":                     now.Add(-7 * 24 * time.Hour),
+		"-1h 30min":               now.Add(-90 * time.Minute),
+		"+5m":                     now.Add(5 * time.Minute),
+		"2 hours ago":             now.Add(-2 * time.Hour),
+		"@1488326400":             time.Unix(1488326400, 0),
+		"2017-01-02 15:04:05":     time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC),
+		"2017-01-02":              time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC),
+		"2017-01-02 15:04:05 UTC": time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC),
+	} {
+		got, err := parseTime(spec, now)
+		assert.Nil(t, err, spec)
+		assert.True(t, got.Equal(want), "%s: %s != %s", spec, got, want)
+	}
+
+	_, err := parseTime("last tuesday", now)
+	assert.NotNil(t, err)
+	_, err = parseTime("-3 fortnights", now)
+	assert.NotNil(t, err)
+}
+
+func TestBoot(t *testing.T) {
+	boots := []string{bootA, bootB, "cccccccccccccccccccccccccccccccc"}
+	for spec, want := range map[string]string{
+		"current":  bootB, // the running boot, even if later boots are in the journal
+		"previous": bootA,
+		"-1":       bootA,
+		"-2":       "",
+		"1":        bootA,
+		"3":        boots[2],
+		"4":        "",
+		bootA:      bootA,
+	} {
+		boot, all := (&filter{bootSpec: spec}).boot(boots, bootB)
+		assert.False(t, all)
+		assert.Equal(t, boot, want, spec)
+	}
+	_, all := (&filter{bootSpec: "all"}).boot(boots, bootB)
+	assert.True(t, all)
+}
+
+func TestExport(t *testing.T) {
+	e := &Entry{
+		Fields:    map[string]string{"MESSAGE": "two\nlines", "PRIORITY": "6"},
+		Realtime:  1,
+		Monotonic: 2,
+		Cursor:    "s=1",
+	}
+	assert.Equal(t, string(export(e)), "MESSAGE\n\x09\x00\x00\x00\x00\x00\x00\x00two\nlines\n"+
+		"PRIORITY=6\n__CURSOR=s=1\n__MONOTONIC_TIMESTAMP=2\n__REALTIME_TIMESTAMP=1\n\n")
 }
@@ -0,0 +1,545 @@
+package journal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Entry is a journal entry as stored in the journal files
+type Entry struct {
+	Fields    map[string]string
+	Realtime  uint64 // microseconds since the epoch
+	Monotonic uint64 // microseconds since boot
+	Cursor    string
+}
+
+// journalFiles reads the entries of a journal in order
+type journalFiles interface {
+	SeekRealtime(t time.Time) error
+	Next() (*Entry, error) // nil at the end of the journal
+	Close() error
+}
+
+// openJournal opens the journal files in dir, or the local journal when dir
+// is empty. It is nil unless mayday is built with the sdjournal tag, in which
+// case journals are read directly instead of through journalctl.
+var openJournal func(dir string) (journalFiles, error)
+
+// currentBoot returns the ID of the running boot, as found in _BOOT_ID
+var currentBoot = func() (string, error) {
+	b, err := ioutil.ReadFile("/proc/sys/kernel/random/boot_id")
+	if err != nil {
+		return "", err
+	}
+	return strings.Replace(strings.TrimSpace(string(b)), "-", "", -1), nil
+}
+
+// Reader reads the journal once and splits the entries between the logs of
+// many units. The entries are kept in a temporary file until the log of
+// their unit is collected, rather than in memory.
+type Reader struct {
+	dir      string
+	journals []*SystemdJournal
+	once     sync.Once
+	err      error
+
+	spool   *os.File // the formatted entries of every unit, in journal order
+	logs    map[*SystemdJournal]*unitLog
+	boots   []string // the boots found in the journal, in order
+	current string   // the running boot
+}
+
+// read splits the journal between the units of r, on first use
+func (r *Reader) read() error {
+	r.once.Do(func() {
+		if r.err = r.split(time.Now()); r.err != nil {
+			log.Printf("reading the journal directly failed, falling back to journalctl: %s", r.err)
+		}
+	})
+	return r.err
+}
+
+// span locates an entry of a unit in the spool file
+type span struct {
+	offset int64
+	length int
+	boot   int // index in Reader.boots
+}
+
+// unitLog gathers the entries of one unit
+type unitLog struct {
+	filter *filter
+	spans  []span
+}
+
+func (r *Reader) split(now time.Time) error {
+	r.logs = make(map[*SystemdJournal]*unitLog)
+	byUnit := make(map[string][]*unitLog)
+	var start time.Time
+	for i, j := range r.journals {
+		f, err := newFilter(j.options, now)
+		if err != nil {
+			return fmt.Errorf("%s: %v", j.name, err)
+		}
+		l := &unitLog{filter: f}
+		r.logs[j] = l
+		byUnit[j.name] = append(byUnit[j.name], l)
+		// the zero time of a unit without a start disables seeking
+		if i == 0 || f.since.Before(start) {
+			start = f.since
+		}
+	}
+
+	files, err := openJournal(r.dir)
+	if err != nil {
+		return err
+	}
+	defer files.Close()
+
+	if !start.IsZero() {
+		if err := files.SeekRealtime(start); err != nil {
+			return err
+		}
+	}
+
+	spool, err := ioutil.TempFile("", "mayday-journal")
+	if err != nil {
+		return err
+	}
+	// the spool is only used through its descriptor, and goes away with it
+	os.Remove(spool.Name())
+	defer func() {
+		if r.spool == nil {
+			spool.Close()
+		}
+	}()
+
+	w := bufio.NewWriter(spool)
+	var offset int64
+	boots := make(map[string]int)
+	for {
+		e, err := files.Next()
+		if err != nil {
+			return err
+		}
+		if e == nil {
+			break
+		}
+
+		boot, ok := boots[e.Fields["_BOOT_ID"]]
+		if !ok {
+			boot = len(r.boots)
+			boots[e.Fields["_BOOT_ID"]] = boot
+			r.boots = append(r.boots, e.Fields["_BOOT_ID"])
+		}
+
+		us := units(e)
+		if e.Fields["_TRANSPORT"] == "kernel" {
+			us = append(us, kernelName)
+		}
+		for _, unit := range us {
+			for _, l := range byUnit[unit] {
+				if l.filter.match(e) {
+					text := l.filter.format(e)
+					w.Write(text)
+					l.spans = append(l.spans, span{offset: offset, length: len(text), boot: boot})
+					offset += int64(len(text))
+				}
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if r.current, err = currentBoot(); err != nil {
+		log.Printf("error reading the current boot ID: %s", err)
+	}
+	r.spool = spool
+	return nil
+}
+
+// content returns the entries of j in the selected boot, limited to the last
+// lines, or nil if they can't be read. The spool is closed once every log
+// has been collected.
+func (r *Reader) content(j *SystemdJournal) *bytes.Buffer {
+	l, ok := r.logs[j]
+	if !ok {
+		return nil
+	}
+	delete(r.logs, j)
+	defer func() {
+		if len(r.logs) == 0 {
+			r.spool.Close()
+		}
+	}()
+
+	spans := l.spans
+	if boot, all := l.filter.boot(r.boots, r.current); !all {
+		var selected []span
+		for _, s := range spans {
+			if boot != "" && r.boots[s.boot] == boot {
+				selected = append(selected, s)
+			}
+		}
+		spans = selected
+	}
+	if n := j.options.Lines; n > 0 && len(spans) > n {
+		spans = spans[len(spans)-n:]
+	}
+
+	var b bytes.Buffer
+	for _, s := range spans {
+		if _, err := b.ReadFrom(io.NewSectionReader(r.spool, s.offset, int64(s.length))); err != nil {
+			log.Printf("error reading the journal of %s: %s", j.name, err)
+			return nil
+		}
+	}
+	return &b
+}
+
+// units returns the units an entry belongs to, matching what journalctl -u
+// shows: messages logged by the unit, and messages pid 1 or coredumps log
+// about it
+func units(e *Entry) []string {
+	var us []string
+	if u := e.Fields["_SYSTEMD_UNIT"]; u != "" {
+		us = append(us, u)
+	}
+	if u := e.Fields["UNIT"]; u != "" && e.Fields["_PID"] == "1" {
+		us = append(us, u)
+	}
+	if e.Fields["_UID"] == "0" {
+		for _, k := range []string{"COREDUMP_UNIT", "OBJECT_SYSTEMD_UNIT"} {
+			if u := e.Fields[k]; u != "" {
+				us = append(us, u)
+			}
+		}
+	}
+	return us
+}
+
+// filter is the native equivalent of the journalctl arguments built from
+// Options
+type filter struct {
+	since, until time.Time
+	minPriority  int // -1 when not filtering by priority
+	maxPriority  int
+	bootSpec     string
+	format       func(*Entry) []byte
+}
+
+var priorities = map[string]int{
+	"emerg": 0, "alert": 1, "crit": 2, "err": 3, "warning": 4, "notice": 5, "info": 6, "debug": 7,
+}
+
+func parsePriority(s string) (int, error) {
+	if p, ok := priorities[s]; ok {
+		return p, nil
+	}
+	p, err := strconv.Atoi(s)
+	if err != nil || p < 0 || p > 7 {
+		return 0, fmt.Errorf("unknown priority %q", s)
+	}
+	return p, nil
+}
+
+var bootIDRe = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+func newFilter(o Options, now time.Time) (*filter, error) {
+	f := &filter{minPriority: -1, bootSpec: o.Boot}
+	var err error
+	if o.Since != "" {
+		if f.since, err = parseTime(o.Since, now); err != nil {
+			return nil, err
+		}
+	}
+	if o.Until != "" {
+		if f.until, err = parseTime(o.Until, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if o.Priority != "" {
+		// a single priority is the lowest that is shown, "FROM..TO" a range
+		lo, hi := "emerg", o.Priority
+		if parts := strings.SplitN(o.Priority, "..", 2); len(parts) == 2 {
+			lo, hi = parts[0], parts[1]
+		}
+		if f.minPriority, err = parsePriority(lo); err != nil {
+			return nil, err
+		}
+		if f.maxPriority, err = parsePriority(hi); err != nil {
+			return nil, err
+		}
+		if f.minPriority > f.maxPriority {
+			f.minPriority, f.maxPriority = f.maxPriority, f.minPriority
+		}
+	}
+
+	switch o.Boot {
+	case "", "all", "current", "previous":
+	default:
+		if _, err := strconv.Atoi(o.Boot); err != nil && !bootIDRe.MatchString(o.Boot) {
+			return nil, fmt.Errorf("unknown boot %q", o.Boot)
+		}
+	}
+
+	format, ok := formats[o.Output]
+	if !ok {
+		return nil, fmt.Errorf("output mode %q is only available through journalctl", o.Output)
+	}
+	f.format = format
+	return f, nil
+}
+
+func (f *filter) match(e *Entry) bool {
+	t := time.Unix(0, int64(e.Realtime)*int64(time.Microsecond))
+	if !f.since.IsZero() && t.Before(f.since) {
+		return false
+	}
+	if !f.until.IsZero() && t.After(f.until) {
+		return false
+	}
+	if f.minPriority >= 0 {
+		p, err := strconv.Atoi(e.Fields["PRIORITY"])
+		if err != nil || p < f.minPriority || p > f.maxPriority {
+			return false
+		}
+	}
+	return true
+}
+
+// boot resolves the boot selection against the boots found in the journal,
+// in order. Offsets count back from the running boot when it is in the
+// journal (0 is the running boot, -1 the one before), and forward from the
+// first boot when positive. An empty boot ID selects nothing.
+func (f *filter) boot(boots []string, current string) (boot string, all bool) {
+	spec := f.bootSpec
+	switch spec {
+	case "", "all":
+		return "", true
+	case "current":
+		spec = "0"
+	case "previous":
+		spec = "-1"
+	}
+	if bootIDRe.MatchString(spec) {
+		return spec, false
+	}
+
+	n, _ := strconv.Atoi(spec)
+	if n > 0 {
+		if n <= len(boots) {
+			return boots[n-1], false
+		}
+		return "", false
+	}
+
+	last := len(boots) - 1
+	for i, b := range boots {
+		if b == current {
+			last = i
+		}
+	}
+	if i := last + n; i >= 0 && i < len(boots) {
+		return boots[i], false
+	}
+	return "", false
+}
+
+// spanUnits are the time span units systemd understands, as in "-1h 30min"
+var spanUnits = map[string]time.Duration{
+	"us": time.Microsecond, "usec": time.Microsecond,
+	"ms": time.Millisecond, "msec": time.Millisecond,
+	"": time.Second, "s": time.Second, "sec": time.Second, "second": time.Second, "seconds": time.Second,
+	"m": time.Minute, "min": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"d": 24 * time.Hour, "day": 24 * time.Hour, "days": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour, "week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+	"M": 2629800 * time.Second, "month": 2629800 * time.Second, "months": 2629800 * time.Second,
+	"y": 31557600 * time.Second, "year": 31557600 * time.Second, "years": 31557600 * time.Second,
+}
+
+var spanRe = regexp.MustCompile(`(\d+)\s*([a-zA-Z]*)`)
+
+func parseSpan(s string) (time.Duration, error) {
+	var d time.Duration
+	rest := strings.TrimSpace(s)
+	for rest != "" {
+		m := spanRe.FindStringSubmatchIndex(rest)
+		if m == nil || m[0] != 0 {
+			return 0, fmt.Errorf("bad time span %q", s)
+		}
+		n, _ := strconv.Atoi(rest[m[2]:m[3]])
+		unit, ok := spanUnits[rest[m[4]:m[5]]]
+		if !ok {
+			return 0, fmt.Errorf("bad time span %q", s)
+		}
+		d += time.Duration(n) * unit
+		rest = strings.TrimSpace(rest[m[1]:])
+	}
+	return d, nil
+}
+
+var timeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006-01-02T15:04:05Z07:00",
+}
+
+// parseTime parses the time specifications journalctl accepts for --since and
+// --until that mayday's configuration uses: "now", "today", "yesterday",
+// "tomorrow", relative spans ("-2d", "+1h 30min"), "@" followed by seconds
+// since the epoch, and absolute dates and times, optionally followed by
+// "UTC". Other times fall back to journalctl.
+func parseTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch s {
+	case "now":
+		return now, nil
+	case "today":
+		return midnight, nil
+	case "yesterday":
+		return midnight.AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return midnight.AddDate(0, 0, 1), nil
+	}
+
+	switch {
+	case strings.HasPrefix(s, "-"), strings.HasPrefix(s, "+"):
+		d, err := parseSpan(s[1:])
+		if err != nil {
+			return time.Time{}, err
+		}
+		if s[0] == '-' {
+			d = -d
+		}
+		return now.Add(d), nil
+	case strings.HasSuffix(s, " ago"):
+		d, err := parseSpan(strings.TrimSuffix(s, " ago"))
+		return now.Add(-d), err
+	case strings.HasPrefix(s, "@"):
+		secs, err := strconv.ParseInt(s[1:], 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("bad time %q", s)
+		}
+		return time.Unix(secs, 0), nil
+	}
+
+	loc := now.Location()
+	if strings.HasSuffix(s, " UTC") {
+		s, loc = strings.TrimSuffix(s, " UTC"), time.UTC
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("bad time %q", s)
+}
+
+// formats render an entry the way the journalctl output mode of the same
+// name does, with --utc
+var formats = map[string]func(*Entry) []byte{
+	"":              short("Jan 02 15:04:05"),
+	"short":         short("Jan 02 15:04:05"),
+	"short-iso":     short("2006-01-02T15:04:05-0700"),
+	"short-precise": short("Jan 02 15:04:05.000000"),
+	"cat":           cat,
+	"json":          jsonEntry,
+	"export":        export,
+}
+
+func realtime(e *Entry) time.Time {
+	return time.Unix(0, int64(e.Realtime)*int64(time.Microsecond)).UTC()
+}
+
+func short(layout string) func(*Entry) []byte {
+	return func(e *Entry) []byte {
+		ident := e.Fields["SYSLOG_IDENTIFIER"]
+		if ident == "" {
+			ident = e.Fields["_COMM"]
+		}
+		pid := e.Fields["SYSLOG_PID"]
+		if pid == "" {
+			pid = e.Fields["_PID"]
+		}
+
+		prefix := realtime(e).Format(layout) + " " + e.Fields["_HOSTNAME"] + " " + ident
+		if pid != "" {
+			prefix += "[" + pid + "]"
+		}
+		prefix += ": "
+		// continuation lines are indented to line up with the first
+		msg := strings.Replace(e.Fields["MESSAGE"], "\n", "\n"+strings.Repeat(" ", len(prefix)), -1)
+		return []byte(prefix + msg + "\n")
+	}
+}
+
+func cat(e *Entry) []byte {
+	return []byte(e.Fields["MESSAGE"] + "\n")
+}
+
+// addressFields adds the fields journalctl prints for the position of an
+// entry in the journal
+func addressFields(e *Entry) map[string]string {
+	fields := make(map[string]string, len(e.Fields)+3)
+	for k, v := range e.Fields {
+		fields[k] = v
+	}
+	fields["__CURSOR"] = e.Cursor
+	fields["__REALTIME_TIMESTAMP"] = strconv.FormatUint(e.Realtime, 10)
+	fields["__MONOTONIC_TIMESTAMP"] = strconv.FormatUint(e.Monotonic, 10)
+	return fields
+}
+
+func jsonEntry(e *Entry) []byte {
+	b, err := json.Marshal(addressFields(e))
+	if err != nil {
+		return []byte(fmt.Sprintf("{\"MESSAGE\": %q}\n", err.Error()))
+	}
+	return append(b, '\n')
+}
+
+// export writes the journal export format: one FIELD=value line per field
+// and a blank line after each entry. Values that aren't plain text are
+// written as the field name, a newline, the little endian 64 bit length and
+// the raw value.
+func export(e *Entry) []byte {
+	fields := addressFields(e)
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b bytes.Buffer
+	for _, k := range keys {
+		v := fields[k]
+		if strings.IndexFunc(v, func(r rune) bool { return r < ' ' && r != '\t' }) < 0 {
+			b.WriteString(k + "=" + v + "\n")
+			continue
+		}
+		b.WriteString(k + "\n")
+		binary.Write(&b, binary.LittleEndian, uint64(len(v)))
+		b.WriteString(v + "\n")
+	}
+	b.WriteString("\n")
+	return b.Bytes()
+}
@@ -0,0 +1,67 @@
+//go:build sdjournal
+// +build sdjournal
+
+package journal
+
+import (
+	"time"
+
+	"github.com/coreos/go-systemd/sdjournal"
+)
+
+// Reading the journal files directly needs the libsystemd headers to build,
+// which is why it is behind the sdjournal tag the build script sets. At
+// runtime libsystemd is opened with dlopen, and journalctl is used when it
+// can't be.
+func init() {
+	openJournal = openSDJournal
+}
+
+type sdJournal struct {
+	j *sdjournal.Journal
+}
+
+func openSDJournal(dir string) (journalFiles, error) {
+	var j *sdjournal.Journal
+	var err error
+	if dir == "" {
+		j, err = sdjournal.NewJournal()
+	} else {
+		j, err = sdjournal.NewJournalFromDir(dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// journalctl -l doesn't truncate fields either
+	if err := j.SetDataThreshold(0); err != nil {
+		j.Close()
+		return nil, err
+	}
+	return &sdJournal{j: j}, nil
+}
+
+func (s *sdJournal) SeekRealtime(t time.Time) error {
+	return s.j.SeekRealtimeUsec(uint64(t.UnixNano() / int64(time.Microsecond)))
+}
+
+func (s *sdJournal) Next() (*Entry, error) {
+	n, err := s.j.Next()
+	if err != nil || n == 0 {
+		return nil, err
+	}
+	e, err := s.j.GetEntry()
+	if err != nil {
+		return nil, err
+	}
+	return &Entry{
+		Fields:    e.Fields,
+		Realtime:  e.RealtimeTimestamp,
+		Monotonic: e.MonotonicTimestamp,
+		Cursor:    e.Cursor,
+	}, nil
+}
+
+func (s *sdJournal) Close() error {
+	return s.j.Close()
+}
@@ -0,0 +1,285 @@
+package kubernetes
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const apiTimeout = 10 * time.Second
+
+var (
+	procRoot = "/proc"
+	// defaultKubeletConfig is where kubeadm writes the kubelet's
+	// configuration file
+	defaultKubeletConfig = "/var/lib/kubelet/config.yaml"
+	// defaultManifests is the usual directory of static pod manifests
+	defaultManifests = "/etc/kubernetes/manifests"
+)
+
+// kubelet is the running kubelet process
+type kubelet struct {
+	pid  int
+	args []string
+}
+
+// findKubelet looks for the kubelet among the running processes, run either
+// as kubelet or as hyperkube kubelet, like kubelet-wrapper does
+func findKubelet() *kubelet {
+	dirs, _ := filepath.Glob(filepath.Join(procRoot, "[0-9]*"))
+	for _, dir := range dirs {
+		pid, err := strconv.Atoi(filepath.Base(dir))
+		if err != nil {
+			continue
+		}
+		cmdline, err := ioutil.ReadFile(filepath.Join(dir, "cmdline"))
+		if err != nil || len(cmdline) == 0 {
+			continue
+		}
+		args := strings.Split(strings.TrimRight(string(cmdline), "\x00"), "\x00")
+		switch filepath.Base(args[0]) {
+		case "kubelet":
+			return &kubelet{pid: pid, args: args}
+		case "hyperkube":
+			if len(args) > 1 && args[1] == "kubelet" {
+				return &kubelet{pid: pid, args: args}
+			}
+		}
+	}
+	return nil
+}
+
+// flag returns the value of a flag given as --name=value or --name value
+func (k *kubelet) flag(name string) string {
+	if k == nil {
+		return ""
+	}
+	for i, a := range k.args {
+		if strings.HasPrefix(a, "--"+name+"=") {
+			return strings.TrimPrefix(a, "--"+name+"=")
+		}
+		if a == "--"+name && i+1 < len(k.args) {
+			return k.args[i+1]
+		}
+	}
+	return ""
+}
+
+// flags lists the command line of the kubelet, one argument per line
+func (k *kubelet) flags(r *redactor) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# pid %d\n", k.pid)
+	for _, a := range r.args(k.args) {
+		fmt.Fprintln(&b, a)
+	}
+	return b.Bytes()
+}
+
+// staticPodPath reads the directory of static pod manifests from the kubelet's
+// configuration file
+func staticPodPath(config string) string {
+	b, err := ioutil.ReadFile(config)
+	if err != nil {
+		return ""
+	}
+	var c struct {
+		StaticPodPath string `yaml:"staticPodPath"`
+	}
+	yaml.Unmarshal(b, &c)
+	return c.StaticPodPath
+}
+
+// credentials authenticate mayday to the kubelet's secure port
+type credentials struct {
+	tls   *tls.Config
+	token string
+}
+
+// kubeletCredentials reads the client certificate and key, or the token, of
+// the current user of the kubelet's own kubeconfig. Relative paths are
+// relative to the kubeconfig.
+func kubeletCredentials(kubeconfig string) (*credentials, error) {
+	b, err := ioutil.ReadFile(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	var kc struct {
+		CurrentContext string `yaml:"current-context"`
+		Contexts       []struct {
+			Name    string `yaml:"name"`
+			Context struct {
+				User string `yaml:"user"`
+			} `yaml:"context"`
+		} `yaml:"contexts"`
+		Users []struct {
+			Name string `yaml:"name"`
+			User struct {
+				ClientCertificate     string `yaml:"client-certificate"`
+				ClientCertificateData string `yaml:"client-certificate-data"`
+				ClientKey             string `yaml:"client-key"`
+				ClientKeyData         string `yaml:"client-key-data"`
+				Token                 string `yaml:"token"`
+			} `yaml:"user"`
+		} `yaml:"users"`
+	}
+	if err := yaml.Unmarshal(b, &kc); err != nil {
+		return nil, fmt.Errorf("%s: unrecognized kubeconfig: %s", kubeconfig, err)
+	}
+
+	var name string
+	for _, c := range kc.Contexts {
+		if c.Name == kc.CurrentContext {
+			name = c.Context.User
+		}
+	}
+	dir := filepath.Dir(kubeconfig)
+	for _, u := range kc.Users {
+		if u.Name != name {
+			continue
+		}
+		// the kubelet's serving certificate is usually self-signed, and not
+		// signed by the cluster's authority
+		c := &credentials{tls: &tls.Config{InsecureSkipVerify: true}, token: u.User.Token}
+		cert, err := pemData(dir, u.User.ClientCertificate, u.User.ClientCertificateData)
+		if err != nil {
+			return nil, err
+		}
+		key, err := pemData(dir, u.User.ClientKey, u.User.ClientKeyData)
+		if err != nil {
+			return nil, err
+		}
+		if cert != nil || key != nil {
+			pair, err := tls.X509KeyPair(cert, key)
+			if err != nil {
+				return nil, fmt.Errorf("%s: client certificate of user %q: %s", kubeconfig, name, err)
+			}
+			c.tls.Certificates = []tls.Certificate{pair}
+		}
+		if c.token == "" && c.tls.Certificates == nil {
+			return nil, fmt.Errorf("%s: no client certificate or token for user %q", kubeconfig, name)
+		}
+		return c, nil
+	}
+	return nil, fmt.Errorf("%s: no user for context %q", kubeconfig, kc.CurrentContext)
+}
+
+// pemData returns the base64 encoded data of a kubeconfig setting, or else
+// the content of the file it names
+func pemData(dir, path, data string) ([]byte, error) {
+	if data != "" {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	if path == "" {
+		return nil, nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	return ioutil.ReadFile(path)
+}
+
+// get returns the body of a successful GET of url, authenticated by auth
+// unless it is nil
+func get(url string, auth *credentials) ([]byte, error) {
+	client := &http.Client{Timeout: apiTimeout}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		client.Transport = &http.Transport{TLSClientConfig: auth.tls}
+		if auth.token != "" {
+			req.Header.Set("Authorization", "Bearer "+auth.token)
+		}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s: %s", url, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// pods returns the pods of the kubelet's /pods endpoint, with their
+// credentials and environment scrubbed. The read-only port is often disabled,
+// in which case they are read from the secure port with the credentials of
+// the kubelet's kubeconfig.
+func pods(c Config, kubeconfig string, r *redactor) []byte {
+	body, err := get(strings.TrimSuffix(c.Kubelet, "/")+"/pods", nil)
+	if err != nil && kubeconfig != "" {
+		var auth *credentials
+		secureErr := fmt.Errorf("not sending the kubelet's credentials to %s, which is not on the loopback interface", c.SecureKubelet)
+		if loopback(c.SecureKubelet) {
+			auth, secureErr = kubeletCredentials(kubeconfig)
+		}
+		if secureErr == nil {
+			body, secureErr = get(strings.TrimSuffix(c.SecureKubelet, "/")+"/pods", auth)
+		}
+		if secureErr != nil {
+			err = fmt.Errorf("%s; %s", err, secureErr)
+		} else {
+			err = nil
+		}
+	}
+	if err != nil {
+		return errorJSON(err)
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return errorJSON(fmt.Errorf("unrecognized pod list: %s", err))
+	}
+	b, err := json.MarshalIndent(r.value(v), "", "  ")
+	if err != nil {
+		return []byte("json marshal error")
+	}
+	return b
+}
+
+// loopback reports whether the host of rawurl is on the loopback interface.
+// The kubelet's serving certificate isn't verified, so its credentials are
+// only sent to a kubelet that can't be another machine.
+func loopback(rawurl string) bool {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return false
+	}
+	if u.Hostname() == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(u.Hostname())
+	return ip != nil && ip.IsLoopback()
+}
+
+// healthz returns the answer of the kubelet's /healthz endpoint
+func healthz(base string) []byte {
+	body, err := get(strings.TrimSuffix(base, "/")+"/healthz", nil)
+	if err != nil {
+		return []byte(fmt.Sprintf("error: %s\n", err))
+	}
+	return append(bytes.TrimSpace(body), '\n')
+}
+
+func errorJSON(err error) []byte {
+	b, _ := json.Marshal(map[string]string{"error": err.Error()})
+	return b
+}
@@ -0,0 +1,180 @@
+package kubernetes
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/coreos/mayday/mayday/scrub"
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+const outputDir = "/kubernetes/"
+
+const (
+	defaultKubelet       = "http://127.0.0.1:10255"
+	defaultSecureKubelet = "https://127.0.0.1:10250"
+	defaultHealthz       = "http://127.0.0.1:10248"
+	defaultMaxKB         = 1024
+)
+
+// defaultDirs hold the configuration of the node's kubernetes components and
+// of its pod network
+var defaultDirs = []string{"/etc/kubernetes", "/etc/cni/net.d"}
+
+// flagFiles hold the flags given to the kubelet by its systemd unit
+var flagFiles = []string{
+	"/var/lib/kubelet/kubeadm-flags.env",
+	"/etc/default/kubelet",
+	"/etc/sysconfig/kubelet",
+}
+
+type Config struct {
+	Dirs          []string `mapstructure:"dirs"`           // configuration directories collected
+	Kubelet       string   `mapstructure:"kubelet"`        // URL of the kubelet's read-only port, serving /pods
+	SecureKubelet string   `mapstructure:"secure_kubelet"` // URL of the kubelet's authenticated port, used when the read-only one fails
+	Healthz       string   `mapstructure:"healthz"`        // URL of the kubelet's health port, serving /healthz
+	MaxKB         int      `mapstructure:"max_kb"`         // larger files are listed but not collected
+}
+
+func (c Config) withDefaults() Config {
+	if len(c.Dirs) == 0 {
+		c.Dirs = defaultDirs
+	}
+	if c.Kubelet == "" {
+		c.Kubelet = defaultKubelet
+	}
+	if c.SecureKubelet == "" {
+		c.SecureKubelet = defaultSecureKubelet
+	}
+	if c.Healthz == "" {
+		c.Healthz = defaultHealthz
+	}
+	if c.MaxKB == 0 {
+		c.MaxKB = defaultMaxKB
+	}
+	return c
+}
+
+// file is a configuration file found on the node
+type file struct {
+	path string
+	size int64
+	note string
+}
+
+// GetNode collects the kubelet's flags and configuration, the static pod
+// manifests, the kubernetes and CNI configuration and the kubelet's /pods and
+// /healthz endpoints. Credentials and private keys are stripped from
+// everything, and the environment of containers is scrubbed by env. It fails
+// when neither a kubelet nor any configuration is found.
+func GetNode(c Config, env *scrub.Scrubber) ([]*tarable.Output, error) {
+	c = c.withDefaults()
+	k := findKubelet()
+
+	// the files of the kubelet are collected wherever they are
+	config := k.flag("config")
+	if config == "" {
+		config = defaultKubeletConfig
+	}
+	manifests := k.flag("pod-manifest-path")
+	if manifests == "" {
+		manifests = staticPodPath(config)
+	}
+	if manifests == "" {
+		manifests = defaultManifests
+	}
+	paths := append([]string{config, manifests}, c.Dirs...)
+	kubeconfig := k.flag("kubeconfig")
+	if kubeconfig != "" {
+		paths = append(paths, kubeconfig)
+	}
+	paths = append(paths, flagFiles...)
+
+	files := find(paths)
+	if k == nil && len(files) == 0 {
+		return nil, errors.New("no kubelet running and no kubernetes configuration found")
+	}
+
+	r := &redactor{env: env}
+	var outputs []*tarable.Output
+	if k != nil {
+		outputs = append(outputs, tarable.NewOutput(outputDir, "kubelet/flags", "kubelet_flags", tarable.Bytes(k.flags(r))))
+	} else {
+		log.Println("No kubelet running, collecting the kubernetes configuration only")
+	}
+
+	for _, f := range files {
+		if f.size > int64(c.MaxKB)*1024 {
+			f.note = fmt.Sprintf("not collected, larger than %d KB", c.MaxKB)
+			continue
+		}
+		b, err := ioutil.ReadFile(f.path)
+		if err != nil {
+			f.note = err.Error()
+			continue
+		}
+		n := r.n
+		content := r.file(b)
+		if r.n > n {
+			f.note = fmt.Sprintf("secrets removed: %d", r.n-n)
+		}
+		link := ""
+		if f.path == config {
+			link = "kubelet_config"
+		}
+		outputs = append(outputs, tarable.NewOutput(outputDir, strings.TrimPrefix(f.path, "/"), link, tarable.Bytes(content)))
+	}
+	outputs = append(outputs, tarable.NewOutput(outputDir, "files", "kubernetes_files", tarable.Bytes(listing(files))))
+
+	outputs = append(outputs, tarable.NewOutput(outputDir, "kubelet/pods.json", "kubelet_pods", tarable.Bytes(pods(c, kubeconfig, r))))
+	outputs = append(outputs, tarable.NewOutput(outputDir, "kubelet/healthz", "kubelet_healthz", tarable.Bytes(healthz(c.Healthz))))
+	return outputs, nil
+}
+
+// find lists the regular files among paths and in the directories among
+// them, each once
+func find(paths []string) []*file {
+	seen := make(map[string]bool)
+	var files []*file
+	for _, p := range paths {
+		filepath.Walk(p, func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				if !os.IsNotExist(err) {
+					log.Printf("error reading %s: %s", path, err)
+				}
+				return nil
+			}
+			if !fi.Mode().IsRegular() || seen[path] {
+				return nil
+			}
+			seen[path] = true
+			files = append(files, &file{path: path, size: fi.Size()})
+			return nil
+		})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+	return files
+}
+
+func listing(files []*file) []byte {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "FILE\tSIZE\tNOTE")
+	for _, f := range files {
+		note := f.note
+		if note == "" {
+			note = "-"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\n", f.path, f.size, note)
+	}
+	w.Flush()
+	return b.Bytes()
+}
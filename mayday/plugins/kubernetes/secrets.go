@@ -0,0 +1,236 @@
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/coreos/mayday/mayday/scrub"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// privateKey matches PEM private keys, such as the ones of
+	// /etc/kubernetes/pki
+	privateKey = regexp.MustCompile(`(?s)-----BEGIN ([A-Z ]*)PRIVATE KEY-----.*?-----END [A-Z ]*PRIVATE KEY-----`)
+	// assignment matches the NAME=value and name: value lines of text files
+	assignment = regexp.MustCompile(`(?m)^(\s*(?:export\s+)?([A-Za-z_][\w.-]*)\s*[=:][ \t]*)(\S.*)$`)
+	// flagValue matches --name=value flags, such as the ones of
+	// KUBELET_EXTRA_ARGS
+	flagValue = regexp.MustCompile(`(--[A-Za-z][\w.-]*)=("[^"]*"|'[^']*'|[^\s"']*)`)
+	// document separates the documents of a YAML file
+	document = regexp.MustCompile(`(?m)^---[ \t]*\n`)
+)
+
+// secretFlag reports whether arg is the value of the secret flag before it,
+// as in --token <value>
+func secretFlag(prev, arg string) bool {
+	return strings.HasPrefix(prev, "--") && !strings.Contains(prev, "=") && scrub.SecretName(prev) && !strings.HasPrefix(arg, "-")
+}
+
+// redactor strips credentials and private keys, and scrubs the environment
+// of containers by env. It counts what it redacts.
+type redactor struct {
+	env *scrub.Scrubber
+	n   int
+}
+
+// file scrubs a configuration file. JSON and YAML files are decoded, scrubbed
+// and encoded again, losing their comments; other files are scrubbed as text.
+func (r *redactor) file(b []byte) []byte {
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		var v interface{}
+		if err := json.Unmarshal(b, &v); err == nil {
+			if out, err := json.MarshalIndent(r.value(v), "", "  "); err == nil {
+				return append(out, '\n')
+			}
+		}
+	}
+	if out, ok := r.yaml(b); ok {
+		return out
+	}
+	return []byte(r.text(string(b)))
+}
+
+// yaml scrubs the documents of a YAML file, if each of them is a mapping
+func (r *redactor) yaml(b []byte) ([]byte, bool) {
+	var docs []yaml.MapSlice
+	for _, d := range document.Split(string(b), -1) {
+		var m yaml.MapSlice
+		if err := yaml.Unmarshal([]byte(d), &m); err != nil {
+			return nil, false
+		}
+		if len(m) > 0 {
+			docs = append(docs, m)
+		}
+	}
+	if len(docs) == 0 {
+		return nil, false
+	}
+
+	// the file falls back to text if it can't be encoded again, forgetting
+	// what was redacted so far
+	n := r.n
+	var out bytes.Buffer
+	for i, d := range docs {
+		if i > 0 {
+			out.WriteString("---\n")
+		}
+		y, err := yaml.Marshal(r.value(d))
+		if err != nil {
+			r.n = n
+			return nil, false
+		}
+		out.Write(y)
+	}
+	return out.Bytes(), true
+}
+
+// value scrubs a decoded JSON or YAML value in place
+func (r *redactor) value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		for i := range v {
+			v[i].Value = r.entry(fmt.Sprint(v[i].Key), v[i].Value)
+		}
+	case map[interface{}]interface{}:
+		for k, e := range v {
+			v[k] = r.entry(fmt.Sprint(k), e)
+		}
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = r.entry(k, e)
+		}
+	case []interface{}:
+		// the values of flags given as separate arguments, e.g.
+		// ["--token", "..."]
+		var prev string
+		for i, e := range v {
+			if s, ok := e.(string); ok && secretFlag(prev, s) {
+				v[i] = r.redact()
+			} else {
+				v[i] = r.value(e)
+			}
+			prev, _ = e.(string)
+		}
+	case string:
+		return r.text(v)
+	}
+	return v
+}
+
+// entry scrubs the value of a setting
+func (r *redactor) entry(k string, v interface{}) interface{} {
+	if _, ok := v.(string); ok && scrub.SecretName(k) {
+		return r.redact()
+	}
+	if k == "env" {
+		r.envList(v)
+	}
+	return r.value(v)
+}
+
+// envList scrubs the values of a list of the environment variables of a
+// container, given as {"name": ..., "value": ...}
+func (r *redactor) envList(v interface{}) {
+	list, ok := v.([]interface{})
+	if !ok {
+		return
+	}
+	for _, e := range list {
+		name, _ := lookup(e, "name").(string)
+		value, ok := lookup(e, "value").(string)
+		if name == "" || !ok {
+			continue
+		}
+		if scrubbed := r.env.Value(name, value); scrubbed != value {
+			r.n++
+			update(e, "value", scrubbed)
+		}
+	}
+}
+
+func lookup(m interface{}, key string) interface{} {
+	switch m := m.(type) {
+	case yaml.MapSlice:
+		for _, item := range m {
+			if item.Key == key {
+				return item.Value
+			}
+		}
+	case map[interface{}]interface{}:
+		return m[key]
+	case map[string]interface{}:
+		return m[key]
+	}
+	return nil
+}
+
+func update(m interface{}, key string, v interface{}) {
+	switch m := m.(type) {
+	case yaml.MapSlice:
+		for i := range m {
+			if m[i].Key == key {
+				m[i].Value = v
+			}
+		}
+	case map[interface{}]interface{}:
+		m[key] = v
+	case map[string]interface{}:
+		m[key] = v
+	}
+}
+
+// text scrubs private keys, secret settings and secret flags out of text.
+// JSON text, such as the kubectl.kubernetes.io/last-applied-configuration
+// annotation, is decoded and scrubbed as a value.
+func (r *redactor) text(s string) string {
+	if t := strings.TrimSpace(s); strings.HasPrefix(t, "{") || strings.HasPrefix(t, "[") {
+		var v interface{}
+		n := r.n
+		if err := json.Unmarshal([]byte(t), &v); err == nil {
+			if b, err := json.Marshal(r.value(v)); err == nil {
+				return string(b)
+			}
+			r.n = n
+		}
+	}
+	s = privateKey.ReplaceAllStringFunc(s, func(key string) string {
+		r.n++
+		return privateKey.ReplaceAllString(key, "-----BEGIN ${1}PRIVATE KEY-----\n"+scrub.Redacted+"\n-----END ${1}PRIVATE KEY-----")
+	})
+	s = assignment.ReplaceAllStringFunc(s, func(line string) string {
+		m := assignment.FindStringSubmatch(line)
+		if !scrub.SecretName(m[2]) {
+			return line
+		}
+		return m[1] + r.redact()
+	})
+	return flagValue.ReplaceAllStringFunc(s, func(flag string) string {
+		m := flagValue.FindStringSubmatch(flag)
+		if !scrub.SecretName(m[1]) {
+			return flag
+		}
+		return m[1] + "=" + r.redact()
+	})
+}
+
+// args scrubs the values of secret flags in a command line
+func (r *redactor) args(args []string) []string {
+	scrubbed := make([]string, len(args))
+	for i, a := range args {
+		if i > 0 && secretFlag(args[i-1], a) {
+			scrubbed[i] = r.redact()
+		} else {
+			scrubbed[i] = r.text(a)
+		}
+	}
+	return scrubbed
+}
+
+func (r *redactor) redact() string {
+	r.n++
+	return scrub.Redacted
+}
@@ -0,0 +1,105 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"net"
+	"syscall"
+	"unsafe"
+)
+
+var routeProtocols = map[uint8]string{
+	syscall.RTPROT_REDIRECT: "redirect",
+	syscall.RTPROT_KERNEL:   "kernel",
+	syscall.RTPROT_BOOT:     "boot",
+	syscall.RTPROT_STATIC:   "static",
+	syscall.RTPROT_RA:       "ra",
+	syscall.RTPROT_DHCP:     "dhcp",
+	12:                      "bird",
+}
+
+var routeScopes = map[uint8]string{
+	syscall.RT_SCOPE_UNIVERSE: "global",
+	syscall.RT_SCOPE_SITE:     "site",
+	syscall.RT_SCOPE_LINK:     "link",
+	syscall.RT_SCOPE_HOST:     "host",
+	syscall.RT_SCOPE_NOWHERE:  "nowhere",
+}
+
+var routeTypes = map[uint8]string{
+	syscall.RTN_UNICAST:     "unicast",
+	syscall.RTN_LOCAL:       "local",
+	syscall.RTN_BROADCAST:   "broadcast",
+	syscall.RTN_ANYCAST:     "anycast",
+	syscall.RTN_MULTICAST:   "multicast",
+	syscall.RTN_BLACKHOLE:   "blackhole",
+	syscall.RTN_UNREACHABLE: "unreachable",
+	syscall.RTN_PROHIBIT:    "prohibit",
+	syscall.RTN_THROW:       "throw",
+	syscall.RTN_NAT:         "nat",
+}
+
+var routeTables = map[uint32]string{
+	syscall.RT_TABLE_DEFAULT: "default",
+	syscall.RT_TABLE_MAIN:    "main",
+	syscall.RT_TABLE_LOCAL:   "local",
+}
+
+// readRoutes dumps every routing table over netlink, like ip route show
+// table all
+func readRoutes() ([]Route, error) {
+	rib, err := syscall.NetlinkRIB(syscall.RTM_GETROUTE, syscall.AF_UNSPEC)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := syscall.ParseNetlinkMessage(rib)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []Route
+	for _, m := range msgs {
+		if m.Header.Type != syscall.RTM_NEWROUTE || len(m.Data) < syscall.SizeofRtMsg {
+			continue
+		}
+		rt := (*syscall.RtMsg)(unsafe.Pointer(&m.Data[0]))
+		attrs, err := syscall.ParseNetlinkRouteAttr(&m)
+		if err != nil {
+			return nil, err
+		}
+
+		r := Route{
+			Family:   "inet",
+			Type:     routeTypes[rt.Type],
+			Protocol: routeProtocols[rt.Protocol],
+			Scope:    routeScopes[rt.Scope],
+			Table:    name(routeTables, uint32(rt.Table)),
+		}
+		if rt.Family == syscall.AF_INET6 {
+			r.Family = "inet6"
+		}
+		r.Destination = "default"
+
+		for _, a := range attrs {
+			switch a.Attr.Type {
+			case syscall.RTA_DST:
+				r.Destination = (&net.IPNet{IP: net.IP(a.Value), Mask: net.CIDRMask(int(rt.Dst_len), 8*len(a.Value))}).String()
+			case syscall.RTA_GATEWAY:
+				r.Gateway = net.IP(a.Value).String()
+			case syscall.RTA_PREFSRC:
+				r.Source = net.IP(a.Value).String()
+			case syscall.RTA_OIF:
+				if iface, err := net.InterfaceByIndex(int(nativeEndian.Uint32(a.Value))); err == nil {
+					r.Interface = iface.Name
+				}
+			case syscall.RTA_PRIORITY:
+				r.Metric = nativeEndian.Uint32(a.Value)
+			case syscall.RTA_TABLE:
+				r.Table = name(routeTables, nativeEndian.Uint32(a.Value))
+			}
+		}
+		routes = append(routes, r)
+	}
+	return routes, nil
+}
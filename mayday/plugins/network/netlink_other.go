@@ -0,0 +1,12 @@
+//go:build !linux
+// +build !linux
+
+package network
+
+import (
+	"errors"
+)
+
+func readRoutes() ([]Route, error) {
+	return nil, errors.New("reading routes is only supported on linux")
+}
@@ -0,0 +1,232 @@
+// Package network collects interfaces, addresses, routes and sockets without
+// relying on the ip or netstat binaries being installed.
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+const outputDir = "/network/"
+
+const (
+	procRoot = "/proc"
+)
+
+type Interface struct {
+	Index        int      `json:"index"`
+	Name         string   `json:"name"`
+	MTU          int      `json:"mtu"`
+	HardwareAddr string   `json:"hardware_addr,omitempty"`
+	Flags        []string `json:"flags"`
+}
+
+type Address struct {
+	Index     int    `json:"index"`
+	Interface string `json:"interface"`
+	Family    string `json:"family"` // inet or inet6
+	Address   string `json:"address"`
+}
+
+type Route struct {
+	Family      string `json:"family"` // inet or inet6
+	Type        string `json:"type"`
+	Destination string `json:"destination"`
+	Gateway     string `json:"gateway,omitempty"`
+	Interface   string `json:"interface,omitempty"`
+	Protocol    string `json:"protocol,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+	Source      string `json:"source,omitempty"`
+	Metric      uint32 `json:"metric,omitempty"`
+	Table       string `json:"table"`
+}
+
+// name returns the name of a well known value, or the value itself
+func name(names map[uint32]string, v uint32) string {
+	if n, ok := names[v]; ok {
+		return n
+	}
+	return fmt.Sprint(v)
+}
+
+// Collector gathers the network state once, on first use
+type Collector struct {
+	root       string
+	once       sync.Once
+	interfaces []Interface
+	addresses  []Address
+	routes     []Route
+	sockets    []Socket
+}
+
+func New() *Collector {
+	return &Collector{root: procRoot}
+}
+
+// collect reads interfaces and addresses (which the net package reads over
+// netlink), routes and sockets
+func (c *Collector) collect() {
+	c.once.Do(func() {
+		log.Printf("Collecting network interfaces, routes and sockets")
+
+		ifaces, err := net.Interfaces()
+		if err != nil {
+			log.Printf("error listing network interfaces: %s", err)
+		}
+		for _, i := range ifaces {
+			iface := Interface{
+				Index:        i.Index,
+				Name:         i.Name,
+				MTU:          i.MTU,
+				HardwareAddr: i.HardwareAddr.String(),
+				Flags:        strings.Split(i.Flags.String(), "|"),
+			}
+			c.interfaces = append(c.interfaces, iface)
+
+			addrs, err := i.Addrs()
+			if err != nil {
+				log.Printf("error listing addresses of %s: %s", i.Name, err)
+				continue
+			}
+			for _, a := range addrs {
+				ipnet, ok := a.(*net.IPNet)
+				if !ok {
+					continue
+				}
+				family := "inet"
+				if ipnet.IP.To4() == nil {
+					family = "inet6"
+				}
+				c.addresses = append(c.addresses, Address{Index: i.Index, Interface: i.Name, Family: family, Address: ipnet.String()})
+			}
+		}
+
+		if c.routes, err = readRoutes(); err != nil {
+			log.Printf("error reading routes: %s", err)
+		}
+
+		if c.sockets, err = readSockets(c.root); err != nil {
+			log.Printf("error reading sockets: %s", err)
+		}
+	})
+}
+
+// Outputs returns the text and JSON files for links, addresses, routes and
+// sockets
+func (c *Collector) Outputs() []*tarable.Output {
+	var outputs []*tarable.Output
+	for _, t := range []struct {
+		name string
+		link string
+		text func(*Collector) string
+		data func(*Collector) interface{}
+	}{
+		{"links", "network_links", linksText, func(c *Collector) interface{} { return c.interfaces }},
+		{"addresses", "network_addresses", addressesText, func(c *Collector) interface{} { return c.addresses }},
+		{"routes", "network_routes", routesText, func(c *Collector) interface{} { return c.routes }},
+		{"sockets", "network_sockets", socketsText, func(c *Collector) interface{} { return c.sockets }},
+	} {
+		t := t
+		outputs = append(outputs,
+			tarable.NewOutput(outputDir, t.name, t.link, func() []byte { c.collect(); return []byte(t.text(c)) }),
+			tarable.NewOutput(outputDir, t.name+".json", "", func() []byte { c.collect(); return renderJSON(t.data(c)) }),
+		)
+	}
+	return outputs
+}
+
+func renderJSON(v interface{}) []byte {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		log.Printf("error marshalling network data: %s", err)
+		return []byte("json marshal error")
+	}
+	return b
+}
+
+// linksText mirrors ip -o link show
+func linksText(c *Collector) string {
+	var b bytes.Buffer
+	for _, i := range c.interfaces {
+		fmt.Fprintf(&b, "%d: %s: <%s> mtu %d", i.Index, i.Name, strings.ToUpper(strings.Join(i.Flags, ",")), i.MTU)
+		if i.HardwareAddr != "" {
+			fmt.Fprintf(&b, " link/ether %s", i.HardwareAddr)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// addressesText mirrors ip -o addr show
+func addressesText(c *Collector) string {
+	var b bytes.Buffer
+	for _, a := range c.addresses {
+		fmt.Fprintf(&b, "%d: %s    %s %s\n", a.Index, a.Interface, a.Family, a.Address)
+	}
+	return b.String()
+}
+
+// routesText mirrors ip route show table all
+func routesText(c *Collector) string {
+	var b bytes.Buffer
+	for _, r := range c.routes {
+		if r.Type != "unicast" {
+			b.WriteString(r.Type + " ")
+		}
+		b.WriteString(r.Destination)
+		for _, kv := range [][2]string{
+			{"via", r.Gateway},
+			{"dev", r.Interface},
+			{"table", r.Table},
+			{"proto", r.Protocol},
+			{"scope", r.Scope},
+			{"src", r.Source},
+		} {
+			if kv[1] != "" && !(kv[0] == "table" && kv[1] == "main") && !(kv[0] == "scope" && kv[1] == "global") {
+				fmt.Fprintf(&b, " %s %s", kv[0], kv[1])
+			}
+		}
+		if r.Metric != 0 {
+			fmt.Fprintf(&b, " metric %d", r.Metric)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// socketsText mirrors netstat -neopa, minus timers
+func socketsText(c *Collector) string {
+	sockets := make([]Socket, len(c.sockets))
+	copy(sockets, c.sockets)
+	// netstat lists internet sockets before unix sockets
+	sort.SliceStable(sockets, func(i, j int) bool {
+		return sockets[i].Protocol != "unix" && sockets[j].Protocol == "unix"
+	})
+
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 1, ' ', 0)
+	fmt.Fprintln(w, "Proto\tRecv-Q\tSend-Q\tLocal Address\tForeign Address\tState\tUser\tInode\tPID/Program name\tPath")
+	for _, s := range sockets {
+		owner := "-"
+		if s.PID != 0 {
+			owner = fmt.Sprintf("%d/%s", s.PID, s.Program)
+		}
+		proto := s.Protocol
+		if s.Type != "" {
+			proto += " " + s.Type
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\t%d\t%d\t%s\t%s\n",
+			proto, s.RecvQ, s.SendQ, s.LocalAddress, s.RemoteAddress, s.State, s.UID, s.Inode, owner, s.Path)
+	}
+	w.Flush()
+	return b.String()
+}
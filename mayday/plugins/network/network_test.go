@@ -0,0 +1,126 @@
+package network
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	procTCP = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100007F:0CEA 00000000:0000 0A 00000000:00000000 00:00000000 00000000   232        0 21807 1 0000000000000000 100 0 0 10 0
+   1: 0C2107AC:0016 6401A8C0:D431 01 00000024:00000000 01:00000019 00000000     0        0 73419 4 0000000000000000 20 4 31 10 -1
+`
+	procTCP6 = `  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000000000000000000000000000:0016 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 20518 1 0000000000000000 100 0 0 10 0
+   1: 00000000000000000000000001000000:1F90 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 20519 1 0000000000000000 100 0 0 10 0
+`
+	procUDP = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops
+  213: 00000000:0044 00000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 18620 2 0000000000000000 0
+`
+	procUnix = `Num       RefCount Protocol Flags    Type St Inode Path
+0000000000000000: 00000002 00000000 00010000 0001 01 15230 /run/systemd/private
+0000000000000000: 00000003 00000000 00000000 0001 03 21900
+0000000000000000: 00000002 00000000 00000000 0002 01 11234 /run/systemd/notify
+`
+)
+
+func TestParseHexAddress(t *testing.T) {
+	defer func(e binary.ByteOrder) { nativeEndian = e }(nativeEndian)
+	nativeEndian = binary.LittleEndian
+	addr, err := parseHexAddress("0100007F:0035")
+	assert.Nil(t, err)
+	assert.Equal(t, addr, "127.0.0.1:53")
+
+	addr, err = parseHexAddress("00000000000000000000000001000000:1F90")
+	assert.Nil(t, err)
+	assert.Equal(t, addr, "[::1]:8080")
+
+	_, err = parseHexAddress("nonsense")
+	assert.NotNil(t, err)
+
+	// big endian hosts print the words in network order
+	nativeEndian = binary.BigEndian
+	addr, err = parseHexAddress("7F000001:0035")
+	assert.Nil(t, err)
+	assert.Equal(t, addr, "127.0.0.1:53")
+}
+
+func TestParseInet(t *testing.T) {
+	sockets, err := parseInet(strings.NewReader(procTCP), "tcp")
+	assert.Nil(t, err)
+	assert.Len(t, sockets, 2)
+	assert.Equal(t, sockets[0], Socket{
+		Protocol: "tcp", LocalAddress: "127.0.0.1:3306", RemoteAddress: "0.0.0.0:0",
+		State: "LISTEN", UID: 232, Inode: 21807,
+	})
+	assert.Equal(t, sockets[1].LocalAddress, "172.7.33.12:22")
+	assert.Equal(t, sockets[1].RemoteAddress, "192.168.1.100:54321")
+	assert.Equal(t, sockets[1].State, "ESTABLISHED")
+	assert.Equal(t, sockets[1].SendQ, uint64(36))
+
+	udp, err := parseInet(strings.NewReader(procUDP), "udp")
+	assert.Nil(t, err)
+	assert.Equal(t, udp[0].LocalAddress, "0.0.0.0:68")
+	assert.Equal(t, udp[0].State, "")
+}
+
+func TestParseUnix(t *testing.T) {
+	sockets, err := parseUnix(strings.NewReader(procUnix))
+	assert.Nil(t, err)
+	assert.Len(t, sockets, 3)
+	assert.Equal(t, sockets[0], Socket{Protocol: "unix", Type: "STREAM", State: "LISTENING", Inode: 15230, Path: "/run/systemd/private"})
+	assert.Equal(t, sockets[1].State, "CONNECTED")
+	assert.Equal(t, sockets[2].Type, "DGRAM")
+}
+
+func TestReadSockets(t *testing.T) {
+	root, err := ioutil.TempDir("", "mayday-network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	os.MkdirAll(filepath.Join(root, "net"), 0755)
+	ioutil.WriteFile(filepath.Join(root, "net", "tcp"), []byte(procTCP), 0644)
+	ioutil.WriteFile(filepath.Join(root, "net", "tcp6"), []byte(procTCP6), 0644)
+	ioutil.WriteFile(filepath.Join(root, "net", "unix"), []byte(procUnix), 0644)
+
+	// pid 42 holds the mysql listener open
+	os.MkdirAll(filepath.Join(root, "42", "fd"), 0755)
+	ioutil.WriteFile(filepath.Join(root, "42", "comm"), []byte("mysqld\n"), 0644)
+	os.Symlink("socket:[21807]", filepath.Join(root, "42", "fd", "7"))
+	os.Symlink("/dev/null", filepath.Join(root, "42", "fd", "0"))
+
+	c := New()
+	c.root = root
+	c.collect()
+
+	assert.Len(t, c.sockets, 7)
+	assert.Equal(t, c.sockets[0].PID, 42)
+	assert.Equal(t, c.sockets[0].Program, "mysqld")
+	assert.Equal(t, c.sockets[1].PID, 0)
+
+	text := socketsText(c)
+	assert.Contains(t, text, "42/mysqld")
+	assert.Contains(t, text, "[::]:22")
+}
+
+func TestOutputs(t *testing.T) {
+	c := New()
+	c.root = "/nonexistent"
+	outputs := c.Outputs()
+	assert.Len(t, outputs, 8)
+	assert.Equal(t, outputs[2].Name(), "/network/addresses")
+	assert.Equal(t, outputs[2].Link(), "network_addresses")
+	assert.Equal(t, outputs[3].Name(), "/network/addresses.json")
+	assert.Equal(t, outputs[3].Link(), "")
+
+	// the loopback interface is always there
+	assert.Contains(t, outputs[0].Content().String(), "lo")
+}
@@ -0,0 +1,229 @@
+package network
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// nativeEndian is the byte order of the host, in which the kernel gives the
+// addresses of /proc/net and the integers of netlink attributes
+var nativeEndian binary.ByteOrder = binary.LittleEndian
+
+func init() {
+	one := uint16(1)
+	if *(*byte)(unsafe.Pointer(&one)) == 0 {
+		nativeEndian = binary.BigEndian
+	}
+}
+
+// Socket is an open socket, as netstat -neopa would show it
+type Socket struct {
+	Protocol      string `json:"protocol"` // tcp, tcp6, udp, udp6 or unix
+	LocalAddress  string `json:"local_address,omitempty"`
+	RemoteAddress string `json:"remote_address,omitempty"`
+	State         string `json:"state"`
+	RecvQ         uint64 `json:"recv_q"`
+	SendQ         uint64 `json:"send_q"`
+	UID           int    `json:"uid"`
+	Inode         uint64 `json:"inode"`
+	PID           int    `json:"pid,omitempty"`
+	Program       string `json:"program,omitempty"`
+	Type          string `json:"type,omitempty"` // unix sockets only: STREAM, DGRAM or SEQPACKET
+	Path          string `json:"path,omitempty"` // unix sockets only
+}
+
+// tcpStates maps the st column of /proc/net/tcp to the names netstat uses
+var tcpStates = map[string]string{
+	"01": "ESTABLISHED",
+	"02": "SYN_SENT",
+	"03": "SYN_RECV",
+	"04": "FIN_WAIT1",
+	"05": "FIN_WAIT2",
+	"06": "TIME_WAIT",
+	"07": "CLOSE",
+	"08": "CLOSE_WAIT",
+	"09": "LAST_ACK",
+	"0A": "LISTEN",
+	"0B": "CLOSING",
+}
+
+var unixTypes = map[string]string{
+	"0001": "STREAM",
+	"0002": "DGRAM",
+	"0005": "SEQPACKET",
+}
+
+const (
+	unixStateConnected = "03"
+	unixFlagAccept     = 0x10000 // __SO_ACCEPTCON: the socket is listening
+)
+
+// parseHexAddress converts an address from /proc/net/tcp, such as
+// "0100007F:0035", to "127.0.0.1:53". IP addresses are printed as 32 bit
+// words in host byte order.
+func parseHexAddress(s string) (string, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return "", fmt.Errorf("bad address %q", s)
+	}
+	b, err := hex.DecodeString(parts[0])
+	if err != nil || (len(b) != net.IPv4len && len(b) != net.IPv6len) {
+		return "", fmt.Errorf("bad address %q", s)
+	}
+	for i := 0; i < len(b); i += 4 {
+		nativeEndian.PutUint32(b[i:], binary.BigEndian.Uint32(b[i:]))
+	}
+	port, err := strconv.ParseUint(parts[1], 16, 16)
+	if err != nil {
+		return "", fmt.Errorf("bad port in %q", s)
+	}
+	return net.JoinHostPort(net.IP(b).String(), strconv.FormatUint(port, 10)), nil
+}
+
+// parseInet parses /proc/net/{tcp,tcp6,udp,udp6}
+func parseInet(r io.Reader, protocol string) ([]Socket, error) {
+	var sockets []Socket
+	scanner := bufio.NewScanner(r)
+	scanner.Scan() // header
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+		local, err := parseHexAddress(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		remote, err := parseHexAddress(fields[2])
+		if err != nil {
+			return nil, err
+		}
+
+		s := Socket{Protocol: protocol, LocalAddress: local, RemoteAddress: remote}
+		if strings.HasPrefix(protocol, "tcp") {
+			s.State = tcpStates[fields[3]]
+		} else if fields[3] == "01" {
+			s.State = "ESTABLISHED"
+		}
+		queues := strings.Split(fields[4], ":")
+		if len(queues) == 2 {
+			s.SendQ, _ = strconv.ParseUint(queues[0], 16, 64)
+			s.RecvQ, _ = strconv.ParseUint(queues[1], 16, 64)
+		}
+		s.UID, _ = strconv.Atoi(fields[7])
+		s.Inode, _ = strconv.ParseUint(fields[9], 10, 64)
+		sockets = append(sockets, s)
+	}
+	return sockets, scanner.Err()
+}
+
+// parseUnix parses /proc/net/unix
+func parseUnix(r io.Reader) ([]Socket, error) {
+	var sockets []Socket
+	scanner := bufio.NewScanner(r)
+	scanner.Scan() // header
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 7 {
+			continue
+		}
+		s := Socket{Protocol: "unix", Type: unixTypes[fields[4]]}
+		flags, _ := strconv.ParseUint(fields[3], 16, 64)
+		switch {
+		case flags&unixFlagAccept != 0:
+			s.State = "LISTENING"
+		case fields[5] == unixStateConnected:
+			s.State = "CONNECTED"
+		}
+		s.Inode, _ = strconv.ParseUint(fields[6], 10, 64)
+		if len(fields) > 7 {
+			s.Path = fields[7]
+		}
+		sockets = append(sockets, s)
+	}
+	return sockets, scanner.Err()
+}
+
+type process struct {
+	pid     int
+	program string
+}
+
+// socketOwners maps socket inodes to the process holding them open, by
+// reading the /proc/<pid>/fd links of every process. Processes that can't be
+// read (e.g. without root) are skipped.
+func socketOwners(root string) map[uint64]process {
+	owners := make(map[uint64]process)
+
+	dirs, err := ioutil.ReadDir(root)
+	if err != nil {
+		return owners
+	}
+	for _, d := range dirs {
+		pid, err := strconv.Atoi(d.Name())
+		if err != nil {
+			continue
+		}
+		fdDir := filepath.Join(root, d.Name(), "fd")
+		fds, err := ioutil.ReadDir(fdDir)
+		if err != nil {
+			continue
+		}
+		comm, _ := ioutil.ReadFile(filepath.Join(root, d.Name(), "comm"))
+		p := process{pid: pid, program: strings.TrimSpace(string(comm))}
+		for _, fd := range fds {
+			target, err := os.Readlink(filepath.Join(fdDir, fd.Name()))
+			if err != nil || !strings.HasPrefix(target, "socket:[") {
+				continue
+			}
+			inode, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(target, "socket:["), "]"), 10, 64)
+			if err == nil {
+				owners[inode] = p
+			}
+		}
+	}
+	return owners
+}
+
+// readSockets reads every socket under root (normally /proc) and attaches
+// the owning process to each
+func readSockets(root string) ([]Socket, error) {
+	var sockets []Socket
+	for _, proto := range []string{"tcp", "tcp6", "udp", "udp6", "unix"} {
+		f, err := os.Open(filepath.Join(root, "net", proto))
+		if err != nil {
+			// e.g. IPv6 is disabled
+			continue
+		}
+		var s []Socket
+		if proto == "unix" {
+			s, err = parseUnix(f)
+		} else {
+			s, err = parseInet(f, proto)
+		}
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s sockets: %v", proto, err)
+		}
+		sockets = append(sockets, s...)
+	}
+
+	owners := socketOwners(root)
+	for i := range sockets {
+		if p, ok := owners[sockets[i].Inode]; ok {
+			sockets[i].PID = p.pid
+			sockets[i].Program = p.program
+		}
+	}
+	return sockets, nil
+}
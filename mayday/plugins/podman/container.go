@@ -0,0 +1,306 @@
+package podman
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/coreos/mayday/mayday/containers"
+	"github.com/coreos/mayday/mayday/scrub"
+	"github.com/coreos/mayday/mayday/tarable"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultLogTail  = 1000
+	defaultLogMaxKB = 1024
+)
+
+// Logs bounds how much of each container's log is collected
+type Logs struct {
+	Tail  int `mapstructure:"tail"`   // the last lines of each log
+	MaxKB int `mapstructure:"max_kb"` // per container, older lines are dropped first
+}
+
+func (l Logs) withDefaults() Logs {
+	if l.Tail == 0 {
+		l.Tail = defaultLogTail
+	}
+	if l.MaxKB == 0 {
+		l.MaxKB = defaultLogMaxKB
+	}
+	return l
+}
+
+// inspect is the part of a container's inspection read by mayday
+type inspect struct {
+	Id           string
+	Name         string
+	Created      string
+	ImageName    string
+	Pod          string
+	RestartCount int
+	State        struct {
+		Status     string
+		ExitCode   int
+		StartedAt  string
+		FinishedAt string
+	}
+	Config struct {
+		Tty bool
+	}
+	Mounts []struct {
+		Type        string
+		Name        string
+		Source      string
+		Destination string
+		RW          bool
+	}
+	NetworkSettings struct {
+		Networks map[string]struct {
+			IPAddress         string
+			GlobalIPv6Address string
+		}
+	}
+}
+
+// Container is a podman container, stored as its inspection with its
+// environment scrubbed
+type Container struct {
+	id      string
+	scope   string // root, or user-<uid> for rootless containers
+	raw     []byte
+	info    *inspect
+	content *bytes.Buffer
+	env     *scrub.Scrubber
+}
+
+func newContainer(id, scope string, raw []byte, env *scrub.Scrubber) *Container {
+	c := &Container{id: id, scope: scope, raw: raw, info: &inspect{}, env: env}
+	if err := json.Unmarshal(raw, c.info); err != nil {
+		log.Printf("error parsing podman container inspection of %s: %s", id, err)
+	}
+	return c
+}
+
+func (c *Container) Content() *bytes.Buffer {
+	if c.content == nil {
+		c.content = bytes.NewBuffer(c.scrubbed())
+	}
+	return c.content
+}
+
+// scrubbed returns the inspection with the values of the environment scrubbed,
+// both in Config.Env and in the flags of Config.CreateCommand, and the
+// credentials of HostConfig, such as log driver tokens, unless in danger mode
+func (c *Container) scrubbed() []byte {
+	var i map[string]json.RawMessage
+	if err := json.Unmarshal(c.raw, &i); err != nil {
+		return []byte("unrecognized podman container inspection")
+	}
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(i["Config"], &config); err == nil {
+		var env []string
+		if err := json.Unmarshal(config["Env"], &env); err == nil {
+			config["Env"], _ = json.Marshal(c.env.Env(env))
+		}
+		var command []string
+		if err := json.Unmarshal(config["CreateCommand"], &command); err == nil {
+			config["CreateCommand"], _ = json.Marshal(scrubCommand(command, c.env))
+		}
+		i["Config"], _ = json.Marshal(config)
+	}
+	if !viper.GetBool("danger") {
+		var hostConfig interface{}
+		if err := json.Unmarshal(i["HostConfig"], &hostConfig); err == nil {
+			i["HostConfig"], _ = json.Marshal(scrub.Secrets(hostConfig))
+		}
+	}
+	b, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		log.Printf("error marshalling podman container %s: %s", c.id, err)
+		return []byte("json marshal error")
+	}
+	return b
+}
+
+// scrubCommand scrubs the variables set by the -e and --env flags of the
+// command line a container was created with
+func scrubCommand(args []string, env *scrub.Scrubber) []string {
+	scrubbed := make([]string, len(args))
+	for i, a := range args {
+		switch {
+		case i > 0 && (args[i-1] == "-e" || args[i-1] == "--env"):
+			scrubbed[i] = env.Env([]string{a})[0]
+		case strings.HasPrefix(a, "--env="):
+			scrubbed[i] = "--env=" + env.Env([]string{strings.TrimPrefix(a, "--env=")})[0]
+		case strings.HasPrefix(a, "-e") && len(a) > 2:
+			scrubbed[i] = "-e" + env.Env([]string{a[2:]})[0]
+		default:
+			scrubbed[i] = a
+		}
+	}
+	return scrubbed
+}
+
+func (c *Container) Header() *tar.Header {
+	return tarable.Header(c.Content(), c.Name())
+}
+
+func (c *Container) Name() string {
+	return "/podman/" + c.scope + "/containers/" + c.id + ".json"
+}
+
+func (c *Container) Link() string {
+	return ""
+}
+
+// status is the container's state, named like the other runtimes name it
+func (c *Container) status() string {
+	switch s := strings.ToLower(c.info.State.Status); s {
+	case "":
+		return containers.Unknown
+	case "configured", "initialized":
+		return containers.Created
+	case "stopped":
+		return containers.Exited
+	default:
+		return s
+	}
+}
+
+// getLogs reads the end of the log of each container through the API
+func getLogs(cl *client, cs []*Container, limits Logs) []*tarable.Output {
+	var logs []*tarable.Output
+	if !viper.GetBool("danger") {
+		return logs
+	}
+	for _, c := range cs {
+		body, err := cl.get("/libpod/containers/" + c.id + "/logs?stdout=true&stderr=true&timestamps=true&tail=" + strconv.Itoa(limits.Tail))
+		if err != nil {
+			body = []byte(fmt.Sprintf("error reading logs: %s\n", err))
+		} else if !c.info.Config.Tty {
+			body = demux(body)
+		}
+		body = limit(body, limits.MaxKB*1024)
+		logs = append(logs, tarable.NewOutput(outputDir, c.scope+"/containers/"+c.id+".log", "", tarable.Bytes(body)))
+	}
+	return logs
+}
+
+// limit keeps the end of a log within max bytes, from the start of a line
+func limit(b []byte, max int) []byte {
+	if len(b) <= max {
+		return b
+	}
+	b = b[len(b)-max:]
+	if i := bytes.IndexByte(b, '\n'); i >= 0 {
+		b = b[i+1:]
+	}
+	return b
+}
+
+// demux joins the frames of the stdout and stderr of a container without a
+// terminal, each made of a header with the stream and the size of the frame
+func demux(b []byte) []byte {
+	var out bytes.Buffer
+	for len(b) >= 8 {
+		size := int(binary.BigEndian.Uint32(b[4:8]))
+		b = b[8:]
+		if size > len(b) {
+			size = len(b)
+		}
+		out.Write(b[:size])
+		b = b[size:]
+	}
+	return out.Bytes()
+}
+
+func summary(cs []*Container) []byte {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CONTAINER\tSCOPE\tNAME\tIMAGE\tSTATUS\tEXIT\tRESTARTS\tPOD")
+	for _, c := range cs {
+		id := c.id
+		if len(id) > 12 {
+			id = id[:12]
+		}
+		pod := c.info.Pod
+		if len(pod) > 12 {
+			pod = pod[:12]
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\n",
+			id, c.scope, orDash(c.info.Name), orDash(c.info.ImageName), c.status(), c.info.State.ExitCode, c.info.RestartCount, orDash(pod))
+	}
+	w.Flush()
+	return b.Bytes()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// Containers describes the containers the way every runtime's are
+func Containers(cs []*Container) []*containers.Container {
+	var described []*containers.Container
+	for _, c := range cs {
+		i := c.info
+		d := &containers.Container{
+			Runtime:      "podman",
+			Id:           c.id,
+			Pod:          i.Pod,
+			Name:         i.Name,
+			Image:        i.ImageName,
+			State:        c.status(),
+			ExitCode:     i.State.ExitCode,
+			Created:      parseTime(i.Created),
+			Started:      parseTime(i.State.StartedAt),
+			RestartCount: i.RestartCount,
+			Dump:         strings.TrimPrefix(c.Name(), "/"),
+		}
+		if d.State == containers.Exited {
+			d.Exited = parseTime(i.State.FinishedAt)
+		}
+		var names []string
+		for n := range i.NetworkSettings.Networks {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		for _, n := range names {
+			net := i.NetworkSettings.Networks[n]
+			d.Networks = append(d.Networks, containers.Network{Name: n, IPv4: net.IPAddress, IPv6: net.GlobalIPv6Address})
+		}
+		for _, m := range i.Mounts {
+			source := m.Source
+			if m.Type == "volume" && m.Name != "" {
+				source = m.Name
+			}
+			d.Mounts = append(d.Mounts, containers.Mount{Source: source, Destination: m.Destination, ReadOnly: !m.RW})
+		}
+		if viper.GetBool("danger") {
+			d.Logs = "podman/" + c.scope + "/containers/" + c.id + ".log"
+		}
+		described = append(described, d)
+	}
+	return described
+}
+
+// parseTime reads the times of an inspection, where the zero time means never
+func parseTime(s string) *time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil
+	}
+	return containers.Time(t)
+}
@@ -0,0 +1,208 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coreos/mayday/mayday/scrub"
+	"github.com/coreos/mayday/mayday/tarable"
+	"github.com/spf13/viper"
+)
+
+const outputDir = "/podman/"
+
+const apiTimeout = 30 * time.Second
+
+// defaultSockets are the sockets of the rootful service and of the rootless
+// service of every user
+var defaultSockets = []string{
+	"/run/podman/podman.sock",
+	"/run/user/*/podman/podman.sock",
+}
+
+type Config struct {
+	Sockets []string `mapstructure:"sockets"` // patterns of the libpod API sockets
+	Logs    Logs     `mapstructure:"logs"`    // collected with --danger
+}
+
+func (c Config) withDefaults() Config {
+	if len(c.Sockets) == 0 {
+		c.Sockets = defaultSockets
+	}
+	c.Logs = c.Logs.withDefaults()
+	return c
+}
+
+// client talks to the libpod API over a unix socket
+type client struct {
+	http *http.Client
+}
+
+func newClient(socket string) *client {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socket)
+		},
+	}
+	return &client{http: &http.Client{Transport: transport, Timeout: apiTimeout}}
+}
+
+// get returns the body of a successful GET of path
+func (c *client) get(path string) ([]byte, error) {
+	// the host is ignored when dialing the socket
+	resp, err := c.http.Get("http://podman" + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		// errors are {"cause": "...", "message": "...", "response": 500}
+		var e struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &e) == nil && e.Message != "" {
+			return nil, fmt.Errorf("GET %s: %s", path, e.Message)
+		}
+		return nil, fmt.Errorf("GET %s: %s", path, resp.Status)
+	}
+	return body, nil
+}
+
+// scope names the service of a socket: root, or user-<uid> for rootless ones
+func scope(socket string) string {
+	parts := strings.Split(filepath.Clean(socket), "/")
+	for i := 0; i+1 < len(parts); i++ {
+		if parts[i] == "user" && i > 0 && parts[i-1] == "run" {
+			return "user-" + parts[i+1]
+		}
+	}
+	return "root"
+}
+
+// endpoints are the service wide API calls collected, by output name
+var endpoints = []struct {
+	name string
+	path string
+}{
+	{"info", "/libpod/info"},
+	{"version", "/libpod/version"},
+	{"images", "/libpod/images/json?all=true"},
+	{"networks", "/libpod/networks/json"},
+	{"volumes", "/libpod/volumes/json"},
+	{"pods", "/libpod/pods/json"},
+}
+
+// GetContainers collects the system info, images, networks, volumes, pods and
+// containers of the rootful and rootless podman services whose sockets are
+// found, and the logs of the containers with --danger. The environments of
+// the containers are scrubbed by env.
+func GetContainers(c Config, env *scrub.Scrubber) ([]*Container, []*tarable.Output, error) {
+	c = c.withDefaults()
+	var sockets []string
+	for _, pattern := range c.Sockets {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, nil, err
+		}
+		sockets = append(sockets, matches...)
+	}
+	if len(sockets) == 0 {
+		return nil, nil, fmt.Errorf("no podman socket found in %s", strings.Join(c.Sockets, ", "))
+	}
+
+	if viper.GetBool("danger") {
+		log.Println("Danger mode activated. Dump will include podman container logs, which may contain sensitive information.")
+	}
+
+	var containers []*Container
+	var outputs []*tarable.Output
+	var err error
+	answered := false
+	for _, socket := range sockets {
+		s := scope(socket)
+		cl := newClient(socket)
+		// fail early, and only once, when the service isn't there
+		if _, pingErr := cl.get("/libpod/_ping"); pingErr != nil {
+			log.Printf("Could not reach the podman service at %s: %s", socket, pingErr)
+			err = pingErr
+			continue
+		}
+		answered = true
+		log.Printf("Collecting podman state from %s", socket)
+
+		for _, e := range endpoints {
+			body, getErr := cl.get(e.path)
+			if getErr != nil {
+				body = errorJSON(getErr)
+			}
+			outputs = append(outputs, tarable.NewOutput(outputDir, s+"/"+e.name+".json", "", tarable.Bytes(indent(body))))
+		}
+
+		cs, listErr := inspectContainers(cl, s, env)
+		if listErr != nil {
+			log.Printf("error listing podman containers at %s: %s", socket, listErr)
+			continue
+		}
+		containers = append(containers, cs...)
+		outputs = append(outputs, getLogs(cl, cs, c.Logs)...)
+	}
+	if !answered {
+		return nil, nil, err
+	}
+	outputs = append(outputs, tarable.NewOutput(outputDir, "containers", "podman_containers", func() []byte { return summary(containers) }))
+	return containers, outputs, nil
+}
+
+// inspectContainers inspects every container, running or not
+func inspectContainers(cl *client, scope string, env *scrub.Scrubber) ([]*Container, error) {
+	body, err := cl.get("/libpod/containers/json?all=true")
+	if err != nil {
+		return nil, err
+	}
+	var list []struct {
+		Id string
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, err
+	}
+
+	var containers []*Container
+	for _, l := range list {
+		inspect, err := cl.get("/libpod/containers/" + l.Id + "/json")
+		if err != nil {
+			// e.g. removed since it was listed
+			log.Printf("error inspecting podman container %s: %s", l.Id, err)
+			continue
+		}
+		containers = append(containers, newContainer(l.Id, scope, inspect, env))
+	}
+	return containers, nil
+}
+
+func indent(body []byte) []byte {
+	var b bytes.Buffer
+	if err := json.Indent(&b, body, "", "  "); err != nil {
+		return body
+	}
+	return b.Bytes()
+}
+
+func errorJSON(err error) []byte {
+	b, _ := json.Marshal(map[string]string{"error": err.Error()})
+	return b
+}
@@ -0,0 +1,201 @@
+package podman
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coreos/mayday/mayday/containers"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeService serves canned responses on a unix socket
+func fakeService(t *testing.T, socket string, responses map[string]string) func() {
+	os.MkdirAll(filepath.Dir(socket), 0755)
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			body = `{"cause": "no such container", "message": "no container with name or ID found", "response": 404}`
+		}
+		w.Write([]byte(body))
+	})
+	go http.Serve(l, mux)
+	return func() { l.Close() }
+}
+
+// frame is a frame of a multiplexed log stream
+func frame(stream byte, s string) string {
+	header := make([]byte, 8)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(s)))
+	return string(header) + s
+}
+
+const webInspect = `{
+	"Id": "4fa6e0f0c6786287e131c3852c58a2e01cc697a68231826813597e4994f1d6e2",
+	"Created": "2021-03-01T10:00:00.123456789+01:00",
+	"Name": "web",
+	"ImageName": "docker.io/library/nginx:1.19",
+	"Pod": "9d4a1c6f2b3e5d7a8c9b0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b",
+	"RestartCount": 2,
+	"State": {"Status": "running", "ExitCode": 0, "StartedAt": "2021-03-01T10:00:01Z", "FinishedAt": "0001-01-01T00:00:00Z"},
+	"Config": {"Env": ["PATH=/usr/bin", "DB_PASSWORD=hunter2", "MODE=prod"], "Tty": false,
+		"CreateCommand": ["podman", "run", "-e", "DB_PASSWORD=hunter2", "--env=MODE=prod", "-eAPI_TOKEN=abc", "--name", "web", "nginx:1.19"]},
+	"HostConfig": {"LogConfig": {"Type": "splunk", "Config": {"splunk-token": "abcd-1234"}}, "Binds": ["/srv/www:/usr/share/nginx/html:ro"]},
+	"Mounts": [{"Type": "bind", "Source": "/srv/www", "Destination": "/usr/share/nginx/html", "RW": false},
+		{"Type": "volume", "Name": "cache", "Source": "/var/lib/containers/storage/volumes/cache/_data", "Destination": "/var/cache/nginx", "RW": true}],
+	"NetworkSettings": {"Networks": {"podman": {"IPAddress": "10.88.0.2"}}}
+}`
+
+func TestGetContainers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mayday-podman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rootful := filepath.Join(dir, "run/podman/podman.sock")
+	defer fakeService(t, rootful, map[string]string{
+		"/libpod/_ping":           "OK",
+		"/libpod/info":            `{"host": {"arch": "amd64", "hostname": "node1"}, "version": {"Version": "3.0.1"}}`,
+		"/libpod/version":         `{"Version": "3.0.1", "ApiVersion": "1.40"}`,
+		"/libpod/images/json":     `[{"Id": "abc", "Names": ["docker.io/library/nginx:1.19"]}]`,
+		"/libpod/networks/json":   `[{"name": "podman", "driver": "bridge"}]`,
+		"/libpod/volumes/json":    `[]`,
+		"/libpod/pods/json":       `[{"Id": "9d4a1c6f2b3e", "Name": "frontend", "Status": "Running"}]`,
+		"/libpod/containers/json": `[{"Id": "4fa6e0f0c6786287e131c3852c58a2e01cc697a68231826813597e4994f1d6e2"}, {"Id": "gone"}]`,
+		"/libpod/containers/4fa6e0f0c6786287e131c3852c58a2e01cc697a68231826813597e4994f1d6e2/json": webInspect,
+		"/libpod/containers/4fa6e0f0c6786287e131c3852c58a2e01cc697a68231826813597e4994f1d6e2/logs": frame(1, "2021-03-01T10:00:02Z GET /\n") + frame(2, "2021-03-01T10:00:03Z error\n"),
+	})()
+	rootless := filepath.Join(dir, "run/user/1000/podman/podman.sock")
+	defer fakeService(t, rootless, map[string]string{
+		"/libpod/_ping":           "OK",
+		"/libpod/containers/json": `[{"Id": "b7e1"}]`,
+		"/libpod/containers/b7e1/json": `{"Id": "b7e1", "Name": "toolbox", "ImageName": "fedora:33",
+			"State": {"Status": "exited", "ExitCode": 1, "StartedAt": "2021-03-01T09:00:00Z", "FinishedAt": "2021-03-01T09:30:00Z"},
+			"Config": {"Env": ["HOME=/home/core"], "Tty": true}}`,
+		"/libpod/containers/b7e1/logs": "raw terminal output\n",
+	})()
+	// a user whose service isn't running
+	os.MkdirAll(filepath.Join(dir, "run/user/1001/podman"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, "run/user/1001/podman/podman.sock"), nil, 0644)
+
+	conf := Config{Sockets: []string{rootful, filepath.Join(dir, "run/user/*/podman/podman.sock")}}
+	viper.Set("danger", false)
+	cs, outputs, err := GetContainers(conf, nil)
+	assert.Nil(t, err)
+
+	byName := make(map[string]string)
+	var ns []string
+	for _, o := range outputs {
+		ns = append(ns, o.Name())
+		byName[o.Name()] = o.Content().String()
+	}
+	assert.Equal(t, ns, []string{
+		"/podman/root/info.json", "/podman/root/version.json", "/podman/root/images.json",
+		"/podman/root/networks.json", "/podman/root/volumes.json", "/podman/root/pods.json",
+		"/podman/user-1000/info.json", "/podman/user-1000/version.json", "/podman/user-1000/images.json",
+		"/podman/user-1000/networks.json", "/podman/user-1000/volumes.json", "/podman/user-1000/pods.json",
+		"/podman/containers",
+	})
+	assert.Contains(t, byName["/podman/root/info.json"], `"hostname": "node1"`)
+	assert.Contains(t, byName["/podman/root/pods.json"], `"Name": "frontend"`)
+	assert.Contains(t, byName["/podman/user-1000/info.json"], `"error": "GET /libpod/info: no container with name or ID found"`)
+	assert.Equal(t, outputs[len(outputs)-1].Link(), "podman_containers")
+	assert.Equal(t, byName["/podman/containers"], `CONTAINER     SCOPE      NAME     IMAGE                         STATUS   EXIT  RESTARTS  POD
+4fa6e0f0c678  root       web      docker.io/library/nginx:1.19  running  0     2         9d4a1c6f2b3e
+b7e1          user-1000  toolbox  fedora:33                     exited   1     0         -
+`)
+
+	assert.Len(t, cs, 2)
+	assert.Equal(t, cs[0].Name(), "/podman/root/containers/4fa6e0f0c6786287e131c3852c58a2e01cc697a68231826813597e4994f1d6e2.json")
+	web := cs[0].Content().String()
+	assert.Contains(t, web, `"PATH=/usr/bin"`)
+	assert.Contains(t, web, `"DB_PASSWORD=scrubbed by mayday"`)
+	assert.Contains(t, web, `"MODE=scrubbed by mayday"`)
+	assert.Contains(t, web, `"ImageName": "docker.io/library/nginx:1.19"`)
+	assert.NotContains(t, web, "hunter2")
+	assert.Contains(t, web, `"--env=MODE=scrubbed by mayday"`)
+	assert.Contains(t, web, `"-eAPI_TOKEN=scrubbed by mayday"`)
+	assert.Contains(t, web, `"--name",`)
+	assert.Contains(t, web, `"splunk-token": "scrubbed by mayday"`)
+	assert.Contains(t, web, `"/srv/www:/usr/share/nginx/html:ro"`)
+	assert.Equal(t, cs[1].Name(), "/podman/user-1000/containers/b7e1.json")
+
+	// logs with --danger, which still scrubs secrets
+	viper.Set("danger", true)
+	defer viper.Set("danger", false)
+	cs, outputs, err = GetContainers(conf, nil)
+	assert.Nil(t, err)
+	byName = make(map[string]string)
+	for _, o := range outputs {
+		byName[o.Name()] = o.Content().String()
+	}
+	root := "/podman/root/containers/4fa6e0f0c6786287e131c3852c58a2e01cc697a68231826813597e4994f1d6e2"
+	assert.Equal(t, byName[root+".log"], "2021-03-01T10:00:02Z GET /\n2021-03-01T10:00:03Z error\n")
+	assert.Equal(t, byName["/podman/user-1000/containers/b7e1.log"], "raw terminal output\n")
+	web = cs[0].Content().String()
+	assert.Contains(t, web, `"MODE=prod"`)
+	assert.Contains(t, web, `"DB_PASSWORD=scrubbed by mayday"`)
+	assert.Contains(t, web, `"--env=MODE=prod"`)
+	assert.NotContains(t, web, "hunter2")
+	assert.Contains(t, web, `"splunk-token": "abcd-1234"`)
+
+	described := Containers(cs)
+	assert.Equal(t, described[0].Runtime, "podman")
+	assert.Equal(t, described[0].Name, "web")
+	assert.Equal(t, described[0].State, "running")
+	assert.Equal(t, described[0].Created.Format("2006-01-02T15:04:05.999999999Z07:00"), "2021-03-01T09:00:00.123456789Z")
+	assert.Nil(t, described[0].Exited)
+	assert.Equal(t, described[0].RestartCount, 2)
+	assert.Equal(t, described[0].Networks, []containers.Network{{Name: "podman", IPv4: "10.88.0.2"}})
+	assert.Equal(t, described[0].Mounts, []containers.Mount{
+		{Source: "/srv/www", Destination: "/usr/share/nginx/html", ReadOnly: true},
+		{Source: "cache", Destination: "/var/cache/nginx"},
+	})
+	assert.Equal(t, described[0].Logs, root[1:]+".log")
+	assert.Equal(t, described[0].Dump, root[1:]+".json")
+	assert.Equal(t, described[1].State, "exited")
+	assert.Equal(t, described[1].Exited.Format("15:04"), "09:30")
+}
+
+func TestNoService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mayday-podman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_, _, err = GetContainers(Config{Sockets: []string{filepath.Join(dir, "*.sock")}}, nil)
+	assert.EqualError(t, err, "no podman socket found in "+filepath.Join(dir, "*.sock"))
+
+	// not listening
+	socket := filepath.Join(dir, "podman.sock")
+	ioutil.WriteFile(socket, nil, 0644)
+	_, _, err = GetContainers(Config{Sockets: []string{socket}}, nil)
+	assert.NotNil(t, err)
+}
+
+func TestScope(t *testing.T) {
+	assert.Equal(t, scope("/run/podman/podman.sock"), "root")
+	assert.Equal(t, scope("/run/user/1000/podman/podman.sock"), "user-1000")
+	assert.Equal(t, scope("/var/run/user/1000/podman/podman.sock"), "user-1000")
+}
+
+func TestLimit(t *testing.T) {
+	log := []byte("one\ntwo\nthree\n")
+	assert.Equal(t, string(limit(log, 100)), "one\ntwo\nthree\n")
+	// the line cut by the limit is left out
+	assert.Equal(t, string(limit(log, 8)), "three\n")
+}
@@ -0,0 +1,194 @@
+package proc
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cpuFields are the columns of a cpu line in /proc/stat, in order
+var cpuFields = []string{"user", "nice", "system", "idle", "iowait", "irq", "softirq", "steal"}
+
+// snapshot holds the counters read from /proc at one point in time
+type snapshot struct {
+	time     time.Time
+	cpu      map[string][]uint64         // cpu name -> jiffies, ordered as cpuFields
+	meminfo  map[string]uint64           // in kB
+	vmstat   map[string]uint64           // event counters
+	disks    map[string]diskCounters     // device -> counters
+	nets     map[string]netCounters      // interface -> counters
+	pressure map[string]pressureCounters // resource -> stall totals
+}
+
+type diskCounters struct {
+	reads, sectorsRead, writes, sectorsWritten, ioTicks uint64
+}
+
+type netCounters struct {
+	rxBytes, rxPackets, rxErrors, rxDrops uint64
+	txBytes, txPackets, txErrors, txDrops uint64
+}
+
+type pressureCounters struct {
+	some, full uint64 // total stall time, in microseconds
+}
+
+// read takes a snapshot of the counters under root (normally /proc). Files
+// that are missing, like /proc/pressure on older kernels, are skipped.
+func read(root string) *snapshot {
+	s := &snapshot{time: time.Now()}
+
+	parseFile(filepath.Join(root, "stat"), func(r io.Reader) { s.cpu = parseStat(r) })
+	parseFile(filepath.Join(root, "meminfo"), func(r io.Reader) { s.meminfo = parseMeminfo(r) })
+	parseFile(filepath.Join(root, "vmstat"), func(r io.Reader) { s.vmstat = parseVmstat(r) })
+	parseFile(filepath.Join(root, "diskstats"), func(r io.Reader) { s.disks = parseDiskstats(r) })
+	parseFile(filepath.Join(root, "net", "dev"), func(r io.Reader) { s.nets = parseNetDev(r) })
+
+	s.pressure = make(map[string]pressureCounters)
+	for _, res := range []string{"cpu", "memory", "io"} {
+		parseFile(filepath.Join(root, "pressure", res), func(r io.Reader) { s.pressure[res] = parsePressure(r) })
+	}
+
+	return s
+}
+
+func parseFile(name string, parse func(io.Reader)) {
+	f, err := os.Open(name)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	parse(f)
+}
+
+func parseUint(s string) uint64 {
+	v, _ := strconv.ParseUint(s, 10, 64)
+	return v
+}
+
+// parseStat reads the cpu lines of /proc/stat
+func parseStat(r io.Reader) map[string][]uint64 {
+	cpus := make(map[string][]uint64)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !strings.HasPrefix(fields[0], "cpu") {
+			continue
+		}
+		jiffies := make([]uint64, len(cpuFields))
+		for i := range cpuFields {
+			if i+1 < len(fields) {
+				jiffies[i] = parseUint(fields[i+1])
+			}
+		}
+		cpus[fields[0]] = jiffies
+	}
+	return cpus
+}
+
+// parseMeminfo reads lines like "MemFree:  123456 kB"
+func parseMeminfo(r io.Reader) map[string]uint64 {
+	mem := make(map[string]uint64)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		mem[strings.TrimSuffix(fields[0], ":")] = parseUint(fields[1])
+	}
+	return mem
+}
+
+// parseVmstat reads lines like "pgfault 123456"
+func parseVmstat(r io.Reader) map[string]uint64 {
+	vm := make(map[string]uint64)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		vm[fields[0]] = parseUint(fields[1])
+	}
+	return vm
+}
+
+// parseDiskstats reads /proc/diskstats; see Documentation/iostats.txt
+func parseDiskstats(r io.Reader) map[string]diskCounters {
+	disks := make(map[string]diskCounters)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 14 {
+			continue
+		}
+		disks[fields[2]] = diskCounters{
+			reads:          parseUint(fields[3]),
+			sectorsRead:    parseUint(fields[5]),
+			writes:         parseUint(fields[7]),
+			sectorsWritten: parseUint(fields[9]),
+			ioTicks:        parseUint(fields[12]),
+		}
+	}
+	return disks
+}
+
+// parseNetDev reads /proc/net/dev, skipping its two header lines
+func parseNetDev(r io.Reader) map[string]netCounters {
+	nets := make(map[string]netCounters)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		fields := strings.Fields(parts[1])
+		if len(fields) < 12 {
+			continue
+		}
+		nets[strings.TrimSpace(parts[0])] = netCounters{
+			rxBytes:   parseUint(fields[0]),
+			rxPackets: parseUint(fields[1]),
+			rxErrors:  parseUint(fields[2]),
+			rxDrops:   parseUint(fields[3]),
+			txBytes:   parseUint(fields[8]),
+			txPackets: parseUint(fields[9]),
+			txErrors:  parseUint(fields[10]),
+			txDrops:   parseUint(fields[11]),
+		}
+	}
+	return nets
+}
+
+// parsePressure reads a /proc/pressure file, e.g.
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=12345
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=6789
+func parsePressure(r io.Reader) pressureCounters {
+	var p pressureCounters
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		var total uint64
+		for _, f := range fields[1:] {
+			if strings.HasPrefix(f, "total=") {
+				total = parseUint(strings.TrimPrefix(f, "total="))
+			}
+		}
+		switch fields[0] {
+		case "some":
+			p.some = total
+		case "full":
+			p.full = total
+		}
+	}
+	return p
+}
@@ -0,0 +1,396 @@
+package proc
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+const outputDir = "/proc_samples/"
+
+const (
+	procRoot        = "/proc"
+	sectorSize      = 512
+	defaultInterval = time.Second
+)
+
+// vmCounters are the /proc/vmstat counters reported as rates
+var vmCounters = []string{"pgpgin", "pgpgout", "pswpin", "pswpout", "pgfault", "pgmajfault", "oom_kill"}
+
+// memFields are the /proc/meminfo values reported with every sample
+var memFields = []string{"MemTotal", "MemFree", "MemAvailable", "Buffers", "Cached", "Dirty", "Writeback", "SwapTotal", "SwapFree"}
+
+// Sample holds the rates observed over one interval
+type Sample struct {
+	Time     time.Time                `json:"time"`    // end of the interval
+	Seconds  float64                  `json:"seconds"` // length of the interval
+	CPU      map[string]CPUUsage      `json:"cpu"`
+	Memory   map[string]uint64        `json:"memory_kb"`
+	VM       map[string]float64       `json:"vm_per_second"`
+	Disks    map[string]DiskRates     `json:"disks"`
+	Networks map[string]NetRates      `json:"networks"`
+	Pressure map[string]PressureStall `json:"pressure,omitempty"`
+}
+
+// CPUUsage is the percentage of time spent in each state, keyed by the
+// names in cpuFields
+type CPUUsage map[string]float64
+
+type DiskRates struct {
+	ReadBytes   float64 `json:"read_bytes_per_second"`
+	WriteBytes  float64 `json:"write_bytes_per_second"`
+	Reads       float64 `json:"reads_per_second"`
+	Writes      float64 `json:"writes_per_second"`
+	UtilPercent float64 `json:"util_percent"`
+}
+
+type NetRates struct {
+	RxBytes   float64 `json:"rx_bytes_per_second"`
+	TxBytes   float64 `json:"tx_bytes_per_second"`
+	RxPackets float64 `json:"rx_packets_per_second"`
+	TxPackets float64 `json:"tx_packets_per_second"`
+	RxErrors  float64 `json:"rx_errors_per_second"`
+	TxErrors  float64 `json:"tx_errors_per_second"`
+	RxDrops   float64 `json:"rx_drops_per_second"`
+	TxDrops   float64 `json:"tx_drops_per_second"`
+}
+
+// PressureStall is the percentage of the interval in which some or all
+// tasks were stalled on a resource
+type PressureStall struct {
+	Some float64 `json:"some_percent"`
+	Full float64 `json:"full_percent"`
+}
+
+// rate returns the per second change of a counter, treating a reset counter
+// as no change
+func rate(prev, cur uint64, secs float64) float64 {
+	if cur < prev || secs <= 0 {
+		return 0
+	}
+	return float64(cur-prev) / secs
+}
+
+// delta computes the rates between two snapshots
+func delta(prev, cur *snapshot) Sample {
+	secs := cur.time.Sub(prev.time).Seconds()
+	s := Sample{
+		Time:     cur.time,
+		Seconds:  secs,
+		CPU:      make(map[string]CPUUsage),
+		Memory:   make(map[string]uint64),
+		VM:       make(map[string]float64),
+		Disks:    make(map[string]DiskRates),
+		Networks: make(map[string]NetRates),
+		Pressure: make(map[string]PressureStall),
+	}
+
+	for name, c := range cur.cpu {
+		p, ok := prev.cpu[name]
+		if !ok {
+			continue
+		}
+		var total float64
+		diffs := make([]float64, len(c))
+		for i := range c {
+			if c[i] >= p[i] {
+				diffs[i] = float64(c[i] - p[i])
+				total += diffs[i]
+			}
+		}
+		usage := make(CPUUsage)
+		for i, field := range cpuFields {
+			if total > 0 {
+				usage[field] = diffs[i] / total * 100
+			} else {
+				usage[field] = 0
+			}
+		}
+		s.CPU[name] = usage
+	}
+
+	for _, k := range memFields {
+		if v, ok := cur.meminfo[k]; ok {
+			s.Memory[k] = v
+		}
+	}
+
+	for _, k := range vmCounters {
+		if v, ok := cur.vmstat[k]; ok {
+			s.VM[k] = rate(prev.vmstat[k], v, secs)
+		}
+	}
+
+	for name, c := range cur.disks {
+		p, ok := prev.disks[name]
+		if !ok {
+			continue
+		}
+		s.Disks[name] = DiskRates{
+			ReadBytes:   rate(p.sectorsRead, c.sectorsRead, secs) * sectorSize,
+			WriteBytes:  rate(p.sectorsWritten, c.sectorsWritten, secs) * sectorSize,
+			Reads:       rate(p.reads, c.reads, secs),
+			Writes:      rate(p.writes, c.writes, secs),
+			UtilPercent: rate(p.ioTicks, c.ioTicks, secs) / 1000 * 100,
+		}
+	}
+
+	for name, c := range cur.nets {
+		p, ok := prev.nets[name]
+		if !ok {
+			continue
+		}
+		s.Networks[name] = NetRates{
+			RxBytes:   rate(p.rxBytes, c.rxBytes, secs),
+			TxBytes:   rate(p.txBytes, c.txBytes, secs),
+			RxPackets: rate(p.rxPackets, c.rxPackets, secs),
+			TxPackets: rate(p.txPackets, c.txPackets, secs),
+			RxErrors:  rate(p.rxErrors, c.rxErrors, secs),
+			TxErrors:  rate(p.txErrors, c.txErrors, secs),
+			RxDrops:   rate(p.rxDrops, c.rxDrops, secs),
+			TxDrops:   rate(p.txDrops, c.txDrops, secs),
+		}
+	}
+
+	for res, c := range cur.pressure {
+		p, ok := prev.pressure[res]
+		if !ok {
+			continue
+		}
+		// stall totals are in microseconds
+		s.Pressure[res] = PressureStall{
+			Some: rate(p.some, c.some, secs) / 1e6 * 100,
+			Full: rate(p.full, c.full, secs) / 1e6 * 100,
+		}
+	}
+
+	return s
+}
+
+// Collector reads /proc at a fixed interval in the background
+type Collector struct {
+	root     string
+	interval time.Duration
+	duration time.Duration
+	samples  []Sample
+	once     sync.Once
+	done     chan struct{} // closed once sampling has finished
+}
+
+func New(interval time.Duration, duration time.Duration) *Collector {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	return &Collector{
+		root:     procRoot,
+		interval: interval,
+		duration: duration,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start begins sampling in the background. Sampling stops after the
+// configured duration, or at deadline if that is sooner and not zero.
+func (c *Collector) Start(deadline time.Time) {
+	c.once.Do(func() {
+		go c.run(deadline)
+	})
+}
+
+func (c *Collector) run(deadline time.Time) {
+	defer close(c.done)
+
+	end := time.Now().Add(c.duration)
+	if !deadline.IsZero() && deadline.Before(end) {
+		end = deadline
+	}
+
+	log.Printf("Sampling %s every %v until %s", c.root, c.interval, end.Format(time.RFC3339))
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	prev := read(c.root)
+	for now := range ticker.C {
+		if now.After(end) {
+			return
+		}
+		cur := read(c.root)
+		c.samples = append(c.samples, delta(prev, cur))
+		prev = cur
+	}
+}
+
+// Samples blocks until sampling has finished and returns every interval
+func (c *Collector) Samples() []Sample {
+	c.Start(time.Time{})
+	<-c.done
+	return c.samples
+}
+
+// Outputs returns a file for each requested format: "json" produces a single
+// file with every sample, "csv" a table per subsystem.
+func (c *Collector) Outputs(formats []string) []*tarable.Output {
+	var outputs []*tarable.Output
+	for _, f := range formats {
+		switch f {
+		case "json":
+			outputs = append(outputs, tarable.NewOutput(outputDir, "samples.json", "", func() []byte { return renderJSON(c.Samples()) }))
+		case "csv":
+			for _, t := range csvTables {
+				render := t.render
+				outputs = append(outputs, tarable.NewOutput(outputDir, t.name+".csv", "", func() []byte { return render(c.Samples()) }))
+			}
+		default:
+			log.Printf("unknown /proc sample format %q", f)
+		}
+	}
+	return outputs
+}
+
+func renderJSON(samples []Sample) []byte {
+	b, err := json.MarshalIndent(samples, "", "  ")
+	if err != nil {
+		log.Printf("error marshalling /proc samples: %s", err)
+		return []byte("json marshal error")
+	}
+	return b
+}
+
+// csvTables lists each CSV file along with the function writing its rows
+var csvTables = []struct {
+	name   string
+	render func([]Sample) []byte
+}{
+	{"cpu", renderCPU},
+	{"memory", renderMemory},
+	{"vm", renderVM},
+	{"disk", renderDisk},
+	{"net", renderNet},
+	{"pressure", renderPressure},
+}
+
+func writeCSV(header []string, rows func(w *csv.Writer)) []byte {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	w.Write(header)
+	rows(w)
+	w.Flush()
+	return b.Bytes()
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]CPUUsage:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]DiskRates:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]NetRates:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]PressureStall:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func ts(s Sample) string {
+	return s.Time.UTC().Format(time.RFC3339Nano)
+}
+
+func f(v float64) string {
+	return fmt.Sprintf("%.2f", v)
+}
+
+func renderCPU(samples []Sample) []byte {
+	return writeCSV(append([]string{"time", "cpu"}, cpuFields...), func(w *csv.Writer) {
+		for _, s := range samples {
+			for _, name := range sortedKeys(s.CPU) {
+				row := []string{ts(s), name}
+				for _, field := range cpuFields {
+					row = append(row, f(s.CPU[name][field]))
+				}
+				w.Write(row)
+			}
+		}
+	})
+}
+
+func renderMemory(samples []Sample) []byte {
+	return writeCSV(append([]string{"time"}, memFields...), func(w *csv.Writer) {
+		for _, s := range samples {
+			row := []string{ts(s)}
+			for _, k := range memFields {
+				row = append(row, fmt.Sprint(s.Memory[k]))
+			}
+			w.Write(row)
+		}
+	})
+}
+
+func renderVM(samples []Sample) []byte {
+	return writeCSV(append([]string{"time"}, vmCounters...), func(w *csv.Writer) {
+		for _, s := range samples {
+			row := []string{ts(s)}
+			for _, k := range vmCounters {
+				row = append(row, f(s.VM[k]))
+			}
+			w.Write(row)
+		}
+	})
+}
+
+func renderDisk(samples []Sample) []byte {
+	header := []string{"time", "device", "read_bytes_per_second", "write_bytes_per_second",
+		"reads_per_second", "writes_per_second", "util_percent"}
+	return writeCSV(header, func(w *csv.Writer) {
+		for _, s := range samples {
+			for _, name := range sortedKeys(s.Disks) {
+				d := s.Disks[name]
+				w.Write([]string{ts(s), name, f(d.ReadBytes), f(d.WriteBytes), f(d.Reads), f(d.Writes), f(d.UtilPercent)})
+			}
+		}
+	})
+}
+
+func renderNet(samples []Sample) []byte {
+	header := []string{"time", "interface", "rx_bytes_per_second", "tx_bytes_per_second",
+		"rx_packets_per_second", "tx_packets_per_second", "rx_errors_per_second",
+		"tx_errors_per_second", "rx_drops_per_second", "tx_drops_per_second"}
+	return writeCSV(header, func(w *csv.Writer) {
+		for _, s := range samples {
+			for _, name := range sortedKeys(s.Networks) {
+				n := s.Networks[name]
+				w.Write([]string{ts(s), name, f(n.RxBytes), f(n.TxBytes), f(n.RxPackets), f(n.TxPackets),
+					f(n.RxErrors), f(n.TxErrors), f(n.RxDrops), f(n.TxDrops)})
+			}
+		}
+	})
+}
+
+func renderPressure(samples []Sample) []byte {
+	return writeCSV([]string{"time", "resource", "some_percent", "full_percent"}, func(w *csv.Writer) {
+		for _, s := range samples {
+			for _, name := range sortedKeys(s.Pressure) {
+				p := s.Pressure[name]
+				w.Write([]string{ts(s), name, f(p.Some), f(p.Full)})
+			}
+		}
+	})
+}
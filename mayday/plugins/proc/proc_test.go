@@ -0,0 +1,133 @@
+package proc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	stat1 = `cpu  100 0 100 700 100 0 0 0 0 0
+cpu0 100 0 100 700 100 0 0 0 0 0
+intr 12345
+ctxt 6789
+`
+	stat2 = `cpu  200 0 150 1300 150 0 0 0 0 0
+cpu0 200 0 150 1300 150 0 0 0 0 0
+intr 12400
+ctxt 6800
+`
+	diskstats1 = `   8       0 sda 100 0 2000 50 200 0 4000 80 0 100 130 0 0 0 0
+`
+	diskstats2 = `   8       0 sda 110 0 4000 55 220 0 8000 90 0 600 145 0 0 0 0
+`
+	netdev1 = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+  eth0:    1000      10    0    0    0     0          0         0     2000      20    0    0    0     0       0          0
+`
+	netdev2 = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+  eth0:    3000      30    1    0    0     0          0         0     2000      20    0    0    0     0       0          0
+`
+	pressure1 = `some avg10=0.00 avg60=0.00 avg300=0.00 total=1000000
+full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+`
+	pressure2 = `some avg10=0.00 avg60=0.00 avg300=0.00 total=1500000
+full avg10=0.00 avg60=0.00 avg300=0.00 total=100000
+`
+)
+
+func TestParseStat(t *testing.T) {
+	cpus := parseStat(strings.NewReader(stat1))
+	assert.Len(t, cpus, 2)
+	assert.Equal(t, cpus["cpu0"], []uint64{100, 0, 100, 700, 100, 0, 0, 0})
+}
+
+func TestParseMeminfo(t *testing.T) {
+	mem := parseMeminfo(strings.NewReader("MemTotal:        8048512 kB\nMemFree:         1234567 kB\nHugePages_Total:       0\n"))
+	assert.Equal(t, mem["MemTotal"], uint64(8048512))
+	assert.Equal(t, mem["MemFree"], uint64(1234567))
+	assert.Equal(t, mem["HugePages_Total"], uint64(0))
+}
+
+func TestParseNetDev(t *testing.T) {
+	nets := parseNetDev(strings.NewReader(netdev1))
+	assert.Len(t, nets, 1)
+	assert.Equal(t, nets["eth0"].rxBytes, uint64(1000))
+	assert.Equal(t, nets["eth0"].txPackets, uint64(20))
+}
+
+func TestDelta(t *testing.T) {
+	start := time.Now()
+	prev := &snapshot{
+		time:     start,
+		cpu:      parseStat(strings.NewReader(stat1)),
+		vmstat:   map[string]uint64{"pgfault": 1000},
+		disks:    parseDiskstats(strings.NewReader(diskstats1)),
+		nets:     parseNetDev(strings.NewReader(netdev1)),
+		pressure: map[string]pressureCounters{"io": parsePressure(strings.NewReader(pressure1))},
+	}
+	cur := &snapshot{
+		time:     start.Add(time.Second),
+		cpu:      parseStat(strings.NewReader(stat2)),
+		meminfo:  map[string]uint64{"MemAvailable": 4096, "Slab": 1},
+		vmstat:   map[string]uint64{"pgfault": 1500},
+		disks:    parseDiskstats(strings.NewReader(diskstats2)),
+		nets:     parseNetDev(strings.NewReader(netdev2)),
+		pressure: map[string]pressureCounters{"io": parsePressure(strings.NewReader(pressure2))},
+	}
+
+	s := delta(prev, cur)
+
+	// 800 jiffies passed: 100 user, 50 system, 600 idle, 50 iowait
+	assert.InDelta(t, s.CPU["cpu"]["user"], 12.5, 0.001)
+	assert.InDelta(t, s.CPU["cpu"]["system"], 6.25, 0.001)
+	assert.InDelta(t, s.CPU["cpu"]["idle"], 75, 0.001)
+	assert.InDelta(t, s.CPU["cpu"]["iowait"], 6.25, 0.001)
+
+	assert.Equal(t, s.Memory, map[string]uint64{"MemAvailable": 4096})
+	assert.InDelta(t, s.VM["pgfault"], 500, 0.001)
+
+	assert.InDelta(t, s.Disks["sda"].ReadBytes, 2000*512, 0.001)
+	assert.InDelta(t, s.Disks["sda"].Writes, 20, 0.001)
+	assert.InDelta(t, s.Disks["sda"].UtilPercent, 50, 0.001)
+
+	assert.InDelta(t, s.Networks["eth0"].RxBytes, 2000, 0.001)
+	assert.InDelta(t, s.Networks["eth0"].TxBytes, 0, 0.001)
+	assert.InDelta(t, s.Networks["eth0"].RxErrors, 1, 0.001)
+
+	assert.InDelta(t, s.Pressure["io"].Some, 50, 0.001)
+	assert.InDelta(t, s.Pressure["io"].Full, 10, 0.001)
+}
+
+func TestCollectorOutputs(t *testing.T) {
+	root, err := ioutil.TempDir("", "mayday-proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	ioutil.WriteFile(filepath.Join(root, "stat"), []byte(stat1), 0644)
+	ioutil.WriteFile(filepath.Join(root, "diskstats"), []byte(diskstats1), 0644)
+
+	c := New(20*time.Millisecond, 70*time.Millisecond)
+	c.root = root
+
+	outputs := c.Outputs([]string{"json", "csv"})
+	assert.Len(t, outputs, 1+len(csvTables))
+	assert.Equal(t, outputs[0].Name(), "/proc_samples/samples.json")
+	assert.Equal(t, outputs[1].Name(), "/proc_samples/cpu.csv")
+
+	c.Start(time.Time{})
+	assert.True(t, len(c.Samples()) >= 2)
+
+	lines := strings.Split(strings.TrimSpace(outputs[1].Content().String()), "\n")
+	assert.Equal(t, lines[0], "time,cpu,user,nice,system,idle,iowait,irq,softirq,steal")
+	// one row for each of cpu and cpu0 per sample
+	assert.Equal(t, len(lines)-1, 2*len(c.Samples()))
+	assert.Contains(t, outputs[0].Content().String(), `"sda"`)
+}
@@ -0,0 +1,132 @@
+package rkt
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/coreos/mayday/mayday/containers"
+	"github.com/coreos/mayday/mayday/plugins/rkt/v1alpha"
+	"github.com/spf13/viper"
+)
+
+// podManifest is the part of a pod manifest telling where the volumes of each
+// app are mounted
+type podManifest struct {
+	Apps []struct {
+		Name   string
+		Mounts []struct {
+			Volume string
+			Path   string
+		}
+	}
+	Volumes []struct {
+		Name     string
+		Kind     string
+		Source   string
+		ReadOnly bool
+	}
+}
+
+// Containers describes the apps of the pods the way every runtime's containers
+// are. Pods without apps, e.g. not inspected, are described as a container.
+func Containers(pods []*Pod) []*containers.Container {
+	var described []*containers.Container
+	for _, p := range pods {
+		var m podManifest
+		if p.Manifest != nil {
+			json.Unmarshal(p.Manifest, &m)
+		}
+
+		pod := func(id, name string) *containers.Container {
+			c := &containers.Container{
+				Runtime: "rkt",
+				Id:      id,
+				Pod:     p.Id,
+				Name:    name,
+				State:   podState(p.State),
+				Created: nanoseconds(p.CreatedAt),
+				Started: nanoseconds(p.StartedAt),
+				Dump:    "rkt/" + p.Id + "/pod.json",
+			}
+			if viper.GetBool("danger") && hasLogs(p.Pod) {
+				if name == "" {
+					name = "pod"
+				}
+				c.Logs = "rkt/" + p.Id + "/" + name + ".log"
+			}
+			for _, n := range p.Networks {
+				c.Networks = append(c.Networks, containers.Network{Name: n.Name, IPv4: n.Ipv4, IPv6: n.Ipv6})
+			}
+			return c
+		}
+
+		if len(p.Apps) == 0 {
+			described = append(described, pod(p.Id, ""))
+			continue
+		}
+		for _, a := range p.Apps {
+			c := pod(p.Id+"/"+a.Name, a.Name)
+			switch a.State {
+			case v1alpha.AppState_APP_STATE_RUNNING:
+				c.State = containers.Running
+			case v1alpha.AppState_APP_STATE_EXITED:
+				c.State = containers.Exited
+			}
+			c.ExitCode = int(a.ExitCode)
+			if a.Image != nil {
+				c.Image = a.Image.Name
+				if a.Image.Version != "" {
+					c.Image += ":" + a.Image.Version
+				}
+			}
+			c.Mounts = m.mounts(a.Name)
+			described = append(described, c)
+		}
+	}
+	return described
+}
+
+// mounts returns the volumes mounted in an app
+func (m podManifest) mounts(app string) []containers.Mount {
+	var mounts []containers.Mount
+	for _, a := range m.Apps {
+		if a.Name != app {
+			continue
+		}
+		for _, mp := range a.Mounts {
+			mount := containers.Mount{Source: mp.Volume, Destination: mp.Path}
+			for _, v := range m.Volumes {
+				if v.Name == mp.Volume {
+					if v.Kind == "host" {
+						mount.Source = v.Source
+					}
+					mount.ReadOnly = v.ReadOnly
+				}
+			}
+			mounts = append(mounts, mount)
+		}
+	}
+	return mounts
+}
+
+// podState is the state of the apps of a pod that doesn't say it for each one
+func podState(s v1alpha.PodState) string {
+	switch s {
+	case v1alpha.PodState_POD_STATE_EMBRYO, v1alpha.PodState_POD_STATE_PREPARING, v1alpha.PodState_POD_STATE_PREPARED:
+		return containers.Created
+	case v1alpha.PodState_POD_STATE_RUNNING:
+		return containers.Running
+	case v1alpha.PodState_POD_STATE_EXITED:
+		return containers.Exited
+	case v1alpha.PodState_POD_STATE_ABORTED_PREPARE, v1alpha.PodState_POD_STATE_DELETING, v1alpha.PodState_POD_STATE_GARBAGE:
+		return containers.Dead
+	}
+	return containers.Unknown
+}
+
+func nanoseconds(ns int64) *time.Time {
+	if ns == 0 {
+		return nil
+	}
+	return containers.Time(time.Unix(0, ns))
+}
@@ -0,0 +1,166 @@
+package rkt
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/coreos/mayday/mayday/plugins/rkt/v1alpha"
+	"github.com/coreos/mayday/mayday/tarable"
+	"golang.org/x/net/context"
+)
+
+// Events selects whether rkt events are recorded while mayday runs
+type Events struct {
+	Enabled bool          `mapstructure:"enabled"`
+	Window  time.Duration `mapstructure:"window"` // how long to listen, until the events are stored when 0
+}
+
+// event is an event as it is stored
+type event struct {
+	Time time.Time         `json:"time"`
+	Type string            `json:"type"`
+	Id   string            `json:"id"`
+	From string            `json:"from"`
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// EventCollector records the events of the API service in the background,
+// such as pods that exit and are restarted while the rest is collected
+type EventCollector struct {
+	conf   Config
+	start  time.Time
+	events []event
+	err    error // why listening stopped early, if it did
+	once   sync.Once
+	cancel func()
+	done   chan struct{} // closed once listening has finished
+}
+
+func NewEventCollector(conf Config) *EventCollector {
+	return &EventCollector{conf: conf.withDefaults(), cancel: func() {}, done: make(chan struct{})}
+}
+
+// Start connects to the API service, which is spawned if needed, and listens
+// in the background until the window ends, Stop is called or deadline is
+// reached if it is not zero
+func (c *EventCollector) Start(deadline time.Time) {
+	c.once.Do(func() {
+		c.start = now()
+		end := deadline
+		if w := c.conf.Events.Window; w > 0 && (end.IsZero() || c.start.Add(w).Before(end)) {
+			end = c.start.Add(w)
+		}
+
+		client, stop, err := connect(c.conf)
+		if err != nil {
+			log.Printf("Could not listen to rkt events: %s", err)
+			c.err = err
+			close(c.done)
+			return
+		}
+
+		var ctx context.Context
+		var cancel context.CancelFunc
+		if end.IsZero() {
+			ctx, cancel = context.WithCancel(context.Background())
+		} else {
+			ctx, cancel = context.WithDeadline(context.Background(), end)
+		}
+		c.cancel = cancel
+		go func() {
+			defer close(c.done)
+			defer stop()
+			defer cancel()
+			c.listen(ctx, client)
+		}()
+	})
+}
+
+func (c *EventCollector) listen(ctx context.Context, client v1alpha.PublicAPIClient) {
+	log.Printf("Listening to rkt events since %s", c.start.Format(time.RFC3339))
+	req := &v1alpha.ListenEventsRequest{Filter: &v1alpha.EventFilter{SinceTime: c.start.Unix()}}
+	stream, err := client.ListenEvents(ctx, req)
+	if err != nil {
+		c.err = err
+		return
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			// the end of the window or Stop() cancels the stream
+			if ctx.Err() == nil {
+				c.err = err
+			}
+			return
+		}
+		for _, e := range resp.Events {
+			c.events = append(c.events, newEvent(e))
+		}
+	}
+}
+
+func newEvent(e *v1alpha.Event) event {
+	ev := event{
+		Time: time.Unix(e.Time, 0).UTC(),
+		Type: strings.TrimPrefix(e.Type.String(), "EVENT_TYPE_"),
+		Id:   e.Id,
+		From: e.From,
+	}
+	if len(e.Data) > 0 {
+		ev.Data = make(map[string]string)
+		for _, kv := range e.Data {
+			ev.Data[kv.Key] = kv.Value
+		}
+	}
+	return ev
+}
+
+// Stop ends listening without waiting for the window to end
+func (c *EventCollector) Stop() {
+	c.once.Do(func() { close(c.done) })
+	c.cancel()
+}
+
+// wait blocks until listening has finished and returns every event. Without a
+// window, listening is stopped first.
+func (c *EventCollector) wait() []event {
+	if c.conf.Events.Window == 0 {
+		c.Stop()
+	}
+	<-c.done
+	return c.events
+}
+
+// Outputs returns the events as text, linked as rkt_events, and as JSON
+func (c *EventCollector) Outputs() []*tarable.Output {
+	return []*tarable.Output{
+		tarable.NewOutput(outputDir, "events", "rkt_events", func() []byte { return c.text(c.wait()) }),
+		tarable.NewOutput(outputDir, "events.json", "", func() []byte { return marshal(c.wait()) }),
+	}
+}
+
+func (c *EventCollector) text(events []event) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Events since %s\n\n", c.start.UTC().Format(time.RFC3339))
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tTYPE\tID\tFROM\tDATA")
+	for _, e := range events {
+		var data []string
+		for k, v := range e.Data {
+			data = append(data, k+"="+v)
+		}
+		sort.Strings(data)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.Time.Format(time.RFC3339), e.Type, e.Id, e.From, strings.Join(data, " "))
+	}
+	w.Flush()
+	if c.err != nil {
+		fmt.Fprintf(&b, "\nstopped listening: %s\n", c.err)
+	}
+	return b.Bytes()
+}
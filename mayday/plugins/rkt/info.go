@@ -0,0 +1,90 @@
+package rkt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"text/tabwriter"
+	"time"
+
+	"github.com/coreos/mayday/mayday/plugins/rkt/v1alpha"
+	"github.com/coreos/mayday/mayday/scrub"
+	"github.com/coreos/mayday/mayday/tarable"
+	"golang.org/x/net/context"
+)
+
+const outputDir = "/rkt/"
+
+// getInfo returns the versions of rkt, appc and the API, and the global
+// flags rkt runs with
+func getInfo(ctx context.Context, c v1alpha.PublicAPIClient) (*tarable.Output, error) {
+	resp, err := c.GetInfo(ctx, &v1alpha.GetInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	info := resp.Info
+	return tarable.NewOutput(outputDir, "info.json", "rkt_info", func() []byte { return marshal(info) }), nil
+}
+
+// imageJSON is an image as it is stored, with its manifest readable
+type imageJSON struct {
+	*v1alpha.Image
+	Manifest json.RawMessage `json:"manifest,omitempty"`
+}
+
+// inspectImages returns a summary of the images, and the details of each with
+// the environment of its manifest scrubbed
+func inspectImages(ctx context.Context, c v1alpha.PublicAPIClient, env *scrub.Scrubber) ([]*tarable.Output, error) {
+	resp, err := c.ListImages(ctx, &v1alpha.ListImagesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var images []*v1alpha.Image
+	for _, i := range resp.Images {
+		inspected, err := c.InspectImage(ctx, &v1alpha.InspectImageRequest{Id: i.Id})
+		if err != nil || inspected.Image == nil {
+			log.Printf("error inspecting image %s: %v", i.Id, err)
+			images = append(images, i)
+			continue
+		}
+		images = append(images, inspected.Image)
+	}
+
+	outputs := []*tarable.Output{
+		tarable.NewOutput(outputDir, "images", "rkt_images", func() []byte { return imagesText(images) }),
+	}
+	for _, i := range images {
+		image := imageJSON{Image: i}
+		if i.Manifest != nil {
+			image.Manifest = scrubManifest(i.Manifest, env)
+		}
+		outputs = append(outputs, tarable.NewOutput(outputDir, "images/"+i.Id+".json", "", func() []byte { return marshal(image) }))
+	}
+	return outputs, nil
+}
+
+func imagesText(images []*v1alpha.Image) []byte {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tVERSION\tIMPORTED\tSIZE")
+	for _, i := range images {
+		imported := "-"
+		if i.ImportTimestamp != 0 {
+			imported = time.Unix(i.ImportTimestamp, 0).UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n", i.Id, i.Name, i.Version, imported, i.Size)
+	}
+	w.Flush()
+	return b.Bytes()
+}
+
+func marshal(v interface{}) []byte {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		log.Printf("error marshalling rkt data: %s", err)
+		return []byte("json marshal error")
+	}
+	return b
+}
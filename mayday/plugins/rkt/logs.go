@@ -0,0 +1,107 @@
+package rkt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/coreos/mayday/mayday/plugins/rkt/v1alpha"
+	"github.com/coreos/mayday/mayday/tarable"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+)
+
+const defaultLogLines = 1000
+
+// Logs bounds how much of each app's log is collected
+type Logs struct {
+	Lines int           `mapstructure:"lines"` // the last lines of each app
+	Since time.Duration `mapstructure:"since"` // only lines logged within this window
+	Until time.Duration `mapstructure:"until"` // only lines logged before this long ago
+}
+
+func (l Logs) withDefaults() Logs {
+	if l.Lines == 0 {
+		l.Lines = defaultLogLines
+	}
+	return l
+}
+
+var now = time.Now
+
+// hasLogs reports whether a pod ran, and so may have logged
+func hasLogs(p *v1alpha.Pod) bool {
+	return p.State == v1alpha.PodState_POD_STATE_RUNNING || p.State == v1alpha.PodState_POD_STATE_EXITED
+}
+
+// getLogs reads the logs of each app of the running and exited pods through
+// the API service, which must be up until they're read
+func getLogs(ctx context.Context, c v1alpha.PublicAPIClient, pods []*Pod, limits Logs) []*tarable.Output {
+	var logs []*tarable.Output
+	if !viper.GetBool("danger") {
+		return logs
+	}
+	log.Println("Danger mode activated. Dump will include rkt pod logs, which may contain sensitive information.")
+
+	limits = limits.withDefaults()
+	req := v1alpha.GetLogsRequest{Lines: int32(limits.Lines)}
+	if limits.Since > 0 {
+		req.SinceTime = now().Add(-limits.Since).Unix()
+	}
+	if limits.Until > 0 {
+		req.UntilTime = now().Add(-limits.Until).Unix()
+	}
+
+	for _, p := range pods {
+		if !hasLogs(p.Pod) {
+			continue
+		}
+		var apps []string
+		for _, a := range p.Apps {
+			apps = append(apps, a.Name)
+		}
+		if len(apps) == 0 {
+			// the logs of all the apps of the pod
+			apps = []string{""}
+		}
+		for _, app := range apps {
+			r := req
+			r.PodId, r.AppName = p.Id, app
+			name := app
+			if name == "" {
+				name = "pod"
+			}
+			log.Printf("Collecting logs of rkt pod %s, app %q", p.Id, app)
+			logs = append(logs, tarable.NewOutput(outputDir, p.Id+"/"+name+".log", "", tarable.Bytes(readLogs(ctx, c, &r))))
+		}
+	}
+	return logs
+}
+
+// readLogs reads a GetLogs stream until its end
+func readLogs(ctx context.Context, c v1alpha.PublicAPIClient, req *v1alpha.GetLogsRequest) []byte {
+	var b bytes.Buffer
+	stream, err := c.GetLogs(ctx, req)
+	if err != nil {
+		log.Printf("error getting logs of rkt pod %s: %s", req.PodId, err)
+		fmt.Fprintf(&b, "error getting logs: %s\n", err)
+		return b.Bytes()
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			log.Printf("error reading logs of rkt pod %s: %s", req.PodId, err)
+			fmt.Fprintf(&b, "error reading logs: %s\n", err)
+			break
+		}
+		for _, l := range resp.Lines {
+			b.WriteString(l)
+			b.WriteString("\n")
+		}
+	}
+	return b.Bytes()
+}
@@ -3,38 +3,63 @@ package rkt
 import (
 	"archive/tar"
 	"bytes"
-	"errors"
+	"encoding/json"
 
-	"github.com/coreos/mayday/mayday/plugins/command"
 	"github.com/coreos/mayday/mayday/plugins/rkt/v1alpha"
+	"github.com/coreos/mayday/mayday/scrub"
 	"github.com/coreos/mayday/mayday/tarable"
-	"github.com/spf13/viper"
 	"golang.org/x/net/context"
-	"google.golang.org/grpc"
-	"gopkg.in/yaml.v2"
 
 	"log"
-	"os/exec"
 	"time"
 )
 
 const (
-	timeout = time.Duration(5 * time.Second)
-)
-
-var (
-	cmd exec.Cmd
+	// apiTimeout bounds all the calls to the API service
+	apiTimeout = 2 * time.Minute
 )
 
 type Pod struct {
 	*v1alpha.Pod
 	content *bytes.Buffer
 	link    string
+	env     *scrub.Scrubber
+}
+
+// podJSON is a pod as it is stored, with its manifest and states readable
+type podJSON struct {
+	*v1alpha.Pod
+	State    string          `json:"state"`
+	Apps     []appJSON       `json:"apps,omitempty"`
+	Manifest json.RawMessage `json:"manifest,omitempty"`
+}
+
+type appJSON struct {
+	*v1alpha.App
+	State string         `json:"state"`
+	Image *v1alpha.Image `json:"image,omitempty"` // without its manifest, stored with the images
 }
 
 func (p *Pod) Content() *bytes.Buffer {
 	if p.content == nil {
-		marshalled, _ := yaml.Marshal(&p.Pod)
+		pod := podJSON{Pod: p.Pod, State: p.State.String()}
+		if p.Manifest != nil {
+			pod.Manifest = scrubManifest(p.Manifest, p.env)
+		}
+		for _, a := range p.Apps {
+			app := appJSON{App: a, State: a.State.String()}
+			if a.Image != nil {
+				image := *a.Image
+				image.Manifest = nil
+				app.Image = &image
+			}
+			pod.Apps = append(pod.Apps, app)
+		}
+		marshalled, err := json.MarshalIndent(pod, "", "  ")
+		if err != nil {
+			log.Printf("error marshalling pod %s: %s", p.Id, err)
+			marshalled = []byte("json marshal error")
+		}
 		p.content = bytes.NewBuffer(marshalled)
 		log.Printf("collecting pod data: %s\n", p.Id)
 	}
@@ -43,7 +68,7 @@ func (p *Pod) Content() *bytes.Buffer {
 }
 
 func (p *Pod) Name() string {
-	return "rkt/" + p.Id
+	return "/rkt/" + p.Id + "/pod.json"
 }
 
 func (p *Pod) Header() *tar.Header {
@@ -54,98 +79,103 @@ func (p *Pod) Link() string {
 	return p.link
 }
 
-var closeApi = func() error {
-	if err := cmd.Process.Kill(); err != nil {
-		log.Printf("Error killing Command: %v", err)
-		return err
-	}
-	return nil
-}
-
-var startApi = func() error {
-	// start rkt in api mode
-	// successful startup is defined as rkt being alive after more than 200
-	// milliseconds -- if it doesn't have permission to get the pod listing,
-	// it generally closes far before then.
-	p, err := exec.LookPath("rkt")
-	if err != nil {
-		log.Println("could not find rkt in PATH")
-		return err
+// errUnrecognizedManifest is stored in place of a manifest that can't be
+// parsed, and so can't be scrubbed. It is a JSON string, as manifests are
+// stored as JSON.
+var errUnrecognizedManifest = []byte(`"unrecognized manifest format"`)
+
+// scrubManifest scrubs the environment of the apps of a pod manifest, or of
+// the app of an image manifest
+func scrubManifest(manifest []byte, env *scrub.Scrubber) []byte {
+	var m map[string]interface{}
+	if err := json.Unmarshal(manifest, &m); err != nil {
+		log.Printf("error parsing manifest: %s", err)
+		return errUnrecognizedManifest
 	}
-	// Set up the actual Cmd to be run
-	cmd = exec.Cmd{
-		Path: p,
-		Args: []string{"rkt", "api-service"},
+	scrubApp := func(app interface{}) {
+		a, _ := app.(map[string]interface{})
+		vars, _ := a["environment"].([]interface{})
+		for _, v := range vars {
+			if nv, ok := v.(map[string]interface{}); ok {
+				name, _ := nv["name"].(string)
+				value, _ := nv["value"].(string)
+				nv["value"] = env.Value(name, value)
+			}
+		}
 	}
-
-	cmd.Start()
-	wc := make(chan error, 1)
-	go func() {
-		wc <- cmd.Wait()
-	}()
-	select {
-	case <-time.After(200 * time.Millisecond):
-		// since it's not ended yet, we're probably good to go
-		return nil
-	case err := <-wc:
-		if err != nil {
-			return err
+	scrubApp(m["app"])
+	apps, _ := m["apps"].([]interface{})
+	for _, a := range apps {
+		if app, ok := a.(map[string]interface{}); ok {
+			scrubApp(app["app"])
 		}
-		return errors.New("rkt closed too quickly")
 	}
+	scrubbed, err := json.Marshal(m)
+	if err != nil {
+		log.Printf("error marshalling manifest: %s", err)
+		return errUnrecognizedManifest
+	}
+	return scrubbed
 }
 
-var podsFromApi = func() ([]*v1alpha.Pod, error) {
-	conn, err := grpc.Dial("localhost:15441", grpc.WithInsecure(), grpc.WithTimeout(timeout))
+// inspectPods lists the pods, with the details of each
+func inspectPods(ctx context.Context, c v1alpha.PublicAPIClient) ([]*v1alpha.Pod, error) {
+	podResp, err := c.ListPods(ctx, &v1alpha.ListPodsRequest{})
 	if err != nil {
 		return nil, err
 	}
 
-	c := v1alpha.NewPublicAPIClient(conn)
-	defer conn.Close()
-
-	podResp, err := c.ListPods(context.Background(), &v1alpha.ListPodsRequest{})
-	return podResp.Pods, err
-}
-
-func getLogs(pods []*Pod) []*command.Command {
-	var logs []*command.Command
-	if viper.GetBool("danger") {
-		log.Println("Danger mode activated. Dump will include rkt pod logs, which may contain sensitive information.")
-
-		if len(pods) != 0 {
-			for _, p := range pods {
-				if p.State == v1alpha.PodState_POD_STATE_RUNNING {
-					logcmd := []string{"journalctl", "-M", "rkt-" + p.Id}
-					cmd := command.New(logcmd, "")
-					cmd.Output = "/rkt/" + p.Id + ".log"
-					logs = append(logs, cmd)
-				}
-			}
+	var pods []*v1alpha.Pod
+	for _, p := range podResp.Pods {
+		resp, err := c.InspectPod(ctx, &v1alpha.InspectPodRequest{Id: p.Id})
+		if err != nil || resp.Pod == nil {
+			// e.g. garbage collected since it was listed
+			log.Printf("error inspecting pod %s: %v", p.Id, err)
+			pods = append(pods, p)
+			continue
 		}
+		pods = append(pods, resp.Pod)
 	}
-	return logs
+	return pods, nil
 }
 
-func GetPods() ([]*Pod, []*command.Command, error) {
+// GetPods inspects the pods through the rkt API service, and collects rkt's
+// information and images, and the logs of the apps with --danger. The
+// environments of the apps are scrubbed by env.
+func GetPods(conf Config, env *scrub.Scrubber) ([]*Pod, []*tarable.Output, error) {
 	var pods []*Pod
-	var logs []*command.Command
+	var outputs []*tarable.Output
+
+	c, stop, err := connect(conf.withDefaults())
+	if err != nil {
+		return pods, outputs, err
+	}
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), apiTimeout)
+	defer cancel()
+
+	if info, err := getInfo(ctx, c); err != nil {
+		log.Printf("error getting rkt info: %s", err)
+	} else {
+		outputs = append(outputs, info)
+	}
 
-	err := startApi()
+	images, err := inspectImages(ctx, c, env)
 	if err != nil {
-		return pods, logs, err
+		log.Printf("error listing rkt images: %s", err)
 	}
-	defer closeApi()
+	outputs = append(outputs, images...)
 
-	apiPods, err := podsFromApi()
+	apiPods, err := inspectPods(ctx, c)
 	if err != nil {
-		return pods, logs, err
+		return pods, outputs, err
 	}
 
 	for _, p := range apiPods {
-		pods = append(pods, &Pod{Pod: p})
+		pods = append(pods, &Pod{Pod: p, env: env})
 	}
 
-	logs = getLogs(pods)
-	return pods, logs, nil
+	outputs = append(outputs, getLogs(ctx, c, pods, conf.Logs)...)
+	return pods, outputs, nil
 }
@@ -2,19 +2,25 @@ package rkt
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
+	"net"
 	"testing"
+	"time"
 
+	"github.com/coreos/mayday/mayday/containers"
 	"github.com/coreos/mayday/mayday/plugins/rkt/v1alpha"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 )
 
 func TestTarable(t *testing.T) {
 	grpcpod := v1alpha.Pod{Id: "abc123"}
 	p := Pod{Pod: &grpcpod}
 
-	assert.Equal(t, p.Header().Name, "rkt/abc123")
+	assert.Equal(t, p.Header().Name, "/rkt/abc123/pod.json")
 
 	content := new(bytes.Buffer)
 	content.ReadFrom(p.Content())
@@ -22,78 +28,432 @@ func TestTarable(t *testing.T) {
 	assert.Contains(t, content.String(), "abc123")
 }
 
+// fakeServer is an in-process api-service
+type fakeServer struct {
+	pods   []*v1alpha.Pod
+	images []*v1alpha.Image
+	logs   map[string][]string // by pod and app
+	reqs   []v1alpha.GetLogsRequest
+	events []*v1alpha.Event
+	since  int64 // of the last ListenEvents request
+}
+
+func (f *fakeServer) GetInfo(ctx context.Context, in *v1alpha.GetInfoRequest) (*v1alpha.GetInfoResponse, error) {
+	return &v1alpha.GetInfoResponse{Info: &v1alpha.Info{
+		RktVersion: "1.25.0", AppcVersion: "0.8.10", ApiVersion: "1.0.0-alpha",
+		GlobalFlags: &v1alpha.GlobalFlags{Dir: "/var/lib/rkt", InsecureFlags: "none"},
+	}}, nil
+}
+
+func (f *fakeServer) ListPods(ctx context.Context, in *v1alpha.ListPodsRequest) (*v1alpha.ListPodsResponse, error) {
+	var pods []*v1alpha.Pod
+	for _, p := range f.pods {
+		// without details
+		pods = append(pods, &v1alpha.Pod{Id: p.Id, State: p.State})
+	}
+	return &v1alpha.ListPodsResponse{Pods: pods}, nil
+}
+
+func (f *fakeServer) InspectPod(ctx context.Context, in *v1alpha.InspectPodRequest) (*v1alpha.InspectPodResponse, error) {
+	for _, p := range f.pods {
+		if p.Id == in.Id {
+			return &v1alpha.InspectPodResponse{Pod: p}, nil
+		}
+	}
+	return nil, errors.New("pod not found")
+}
+
+func (f *fakeServer) ListImages(ctx context.Context, in *v1alpha.ListImagesRequest) (*v1alpha.ListImagesResponse, error) {
+	var images []*v1alpha.Image
+	for _, i := range f.images {
+		images = append(images, &v1alpha.Image{Id: i.Id, Name: i.Name})
+	}
+	return &v1alpha.ListImagesResponse{Images: images}, nil
+}
+
+func (f *fakeServer) InspectImage(ctx context.Context, in *v1alpha.InspectImageRequest) (*v1alpha.InspectImageResponse, error) {
+	for _, i := range f.images {
+		if i.Id == in.Id {
+			return &v1alpha.InspectImageResponse{Image: i}, nil
+		}
+	}
+	return nil, errors.New("image not found")
+}
+
+func (f *fakeServer) ListenEvents(in *v1alpha.ListenEventsRequest, stream v1alpha.PublicAPI_ListenEventsServer) error {
+	f.since = in.Filter.SinceTime
+	// one event per response, then none until the client goes away
+	for _, e := range f.events {
+		if err := stream.Send(&v1alpha.ListenEventsResponse{Events: []*v1alpha.Event{e}}); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func (f *fakeServer) GetLogs(in *v1alpha.GetLogsRequest, stream v1alpha.PublicAPI_GetLogsServer) error {
+	f.reqs = append(f.reqs, *in)
+	lines, ok := f.logs[in.PodId+"/"+in.AppName]
+	if !ok {
+		return errors.New("no logs")
+	}
+	// two lines per response
+	for i := 0; i < len(lines); i += 2 {
+		end := i + 2
+		if end > len(lines) {
+			end = len(lines)
+		}
+		if err := stream.Send(&v1alpha.GetLogsResponse{Lines: lines[i:end]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serve runs f at address, or at a free port when empty
+func serve(t *testing.T, f *fakeServer, address string) (string, func()) {
+	if address == "" {
+		address = "127.0.0.1:0"
+	}
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	v1alpha.RegisterPublicAPIServer(s, f)
+	go s.Serve(l)
+	return l.Addr().String(), s.Stop
+}
+
+// freeAddress returns an address nothing listens on
+func freeAddress(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+// fakeService is a spawned api-service serving a fakeServer after a delay
+type fakeService struct {
+	done    chan struct{}
+	stop    func()
+	stopped bool
+}
+
+func (f *fakeService) Exited() <-chan struct{} {
+	return f.done
+}
+
+func (f *fakeService) Stop() error {
+	f.stopped = true
+	if f.stop != nil {
+		f.stop()
+	}
+	return nil
+}
+
 func TestGetLogs(t *testing.T) {
-	p1 := v1alpha.Pod{Id: "abc123", State: v1alpha.PodState_POD_STATE_RUNNING}
-	p2 := v1alpha.Pod{Id: "xyz789", State: v1alpha.PodState_POD_STATE_EXITED}
+	server := &fakeServer{logs: map[string][]string{
+		"abc123/etcd":  {"one", "two", "three"},
+		"xyz789/":      {"crashed"},
+		"abc123/proxy": nil,
+	}}
+	address, stop := serve(t, server, "")
+	defer stop()
+	client, closeConn, err := dialApi(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeConn()
+
+	pods := []*Pod{
+		{Pod: &v1alpha.Pod{Id: "abc123", State: v1alpha.PodState_POD_STATE_RUNNING,
+			Apps: []*v1alpha.App{{Name: "etcd"}, {Name: "proxy"}, {Name: "gone"}}}},
+		// exited pods are the ones that crashed
+		{Pod: &v1alpha.Pod{Id: "xyz789", State: v1alpha.PodState_POD_STATE_EXITED}},
+		{Pod: &v1alpha.Pod{Id: "def456", State: v1alpha.PodState_POD_STATE_PREPARED}},
+	}
 
-	pods := []*Pod{{Pod: &p1}, {Pod: &p2}}
+	viper.Set("danger", false)
+	assert.Len(t, getLogs(context.Background(), client, pods, Logs{}), 0)
 
 	viper.Set("danger", true)
-	logs := getLogs(pods)
-	assert.Equal(t, len(logs), 1)
-	// log command is correct
-	assert.EqualValues(t, logs[0].Args(), []string{"journalctl", "-M", "rkt-abc123"})
-	// output will be to correct file
-	assert.Equal(t, logs[0].Name(), "/rkt/abc123.log")
+	defer viper.Set("danger", false)
+	now = func() time.Time { return time.Unix(1488326400, 0) }
+	defer func() { now = time.Now }()
 
-	viper.Set("danger", false)
-	logs1 := getLogs(pods)
-	assert.Equal(t, len(logs1), 0)
+	logs := getLogs(context.Background(), client, pods, Logs{Since: time.Hour, Until: time.Minute})
+	var ns []string
+	for _, l := range logs {
+		ns = append(ns, l.Name())
+	}
+	assert.Equal(t, ns, []string{"/rkt/abc123/etcd.log", "/rkt/abc123/proxy.log", "/rkt/abc123/gone.log", "/rkt/xyz789/pod.log"})
+	assert.Equal(t, logs[0].Content().String(), "one\ntwo\nthree\n")
+	assert.Equal(t, logs[1].Content().String(), "")
+	assert.Contains(t, logs[2].Content().String(), "no logs")
+	assert.Equal(t, logs[3].Content().String(), "crashed\n")
+
+	assert.Equal(t, server.reqs[0], v1alpha.GetLogsRequest{PodId: "abc123", AppName: "etcd", Lines: 1000, SinceTime: 1488322800, UntilTime: 1488326340})
 }
 
-func TestGracefulFail(t *testing.T) {
-	// tests that if startApi() fails, podsFromApi() and closeApi() are never called
-	startCalled := false
-	closedCalled := false
-	podsCalled := false
+func TestGetPods(t *testing.T) {
+	viper.Set("danger", false)
+	server := &fakeServer{
+		pods: []*v1alpha.Pod{{
+			Id: "abc123", State: v1alpha.PodState_POD_STATE_RUNNING, Pid: 42,
+			Apps: []*v1alpha.App{{Name: "etcd", State: v1alpha.AppState_APP_STATE_RUNNING,
+				Image: &v1alpha.Image{Id: "sha512-1234", Manifest: []byte(`{"acKind": "ImageManifest"}`)}}},
+			Manifest: []byte(`{"acKind":"PodManifest","apps":[{"name":"etcd","app":{"environment":[{"name":"ETCD_NAME","value":"node1"}]}}]}`),
+		}},
+		images: []*v1alpha.Image{{
+			Id: "sha512-1234", Name: "coreos.com/etcd", Version: "v3.1.0", ImportTimestamp: 1488326400, Size: 1024,
+			Manifest: []byte(`{"acKind":"ImageManifest","name":"coreos.com/etcd","app":{"environment":[{"name":"PATH","value":"/usr/bin"},{"name":"ETCD_PEER_KEY","value":"abc"}]}}`),
+		}},
+	}
+	address, stop := serve(t, server, "")
+	defer stop()
 
-	startApi = func() error {
-		startCalled = true
-		return errors.New("api fail")
+	// the running api-service is used
+	spawnApi = func(address string) (apiService, error) {
+		t.Fatal("api-service spawned")
+		return nil, nil
 	}
+	pods, outputs, err := GetPods(Config{Address: address}, nil)
+	assert.Nil(t, err)
 
-	closeApi = func() error {
-		closedCalled = true
-		return nil
+	byName := make(map[string]string)
+	var ns []string
+	for _, o := range outputs {
+		ns = append(ns, o.Name())
+		byName[o.Name()] = o.Content().String()
 	}
+	assert.Equal(t, ns, []string{"/rkt/info.json", "/rkt/images", "/rkt/images/sha512-1234.json"})
+	assert.Contains(t, byName["/rkt/info.json"], `"rkt_version": "1.25.0"`)
+	assert.Contains(t, byName["/rkt/info.json"], `"dir": "/var/lib/rkt"`)
+	assert.Equal(t, byName["/rkt/images"], `ID           NAME             VERSION  IMPORTED              SIZE
+sha512-1234  coreos.com/etcd  v3.1.0   2017-03-01T00:00:00Z  1024
+`)
+	image := byName["/rkt/images/sha512-1234.json"]
+	assert.Contains(t, image, `"manifest": {
+    "acKind": "ImageManifest",`)
+	assert.Contains(t, image, `"value": "/usr/bin"`)
+	assert.NotContains(t, image, `"abc"`)
 
-	podsFromApi = func() ([]*v1alpha.Pod, error) {
-		podsCalled = true
-		return nil, nil
+	// the inspected pod, not the one listed
+	assert.Len(t, pods, 1)
+	assert.EqualValues(t, pods[0].Pid, 42)
+	pod := pods[0].Content().String()
+	assert.Contains(t, pod, `"state": "POD_STATE_RUNNING"`)
+	assert.Contains(t, pod, `"state": "APP_STATE_RUNNING"`)
+	assert.Contains(t, pod, `"manifest": {
+    "acKind": "PodManifest",`)
+	assert.Contains(t, pod, `"value": "scrubbed by mayday"`)
+	// the image manifest is only stored with the image
+	assert.NotContains(t, pod, "ImageManifest")
+}
+
+func TestSpawn(t *testing.T) {
+	address := freeAddress(t)
+	svc := &fakeService{done: make(chan struct{})}
+	spawnApi = func(a string) (apiService, error) {
+		assert.Equal(t, a, address)
+		// answers after a while
+		go func() {
+			time.Sleep(300 * time.Millisecond)
+			_, svc.stop = serve(t, &fakeServer{pods: []*v1alpha.Pod{{Id: "abc123"}}}, address)
+		}()
+		return svc, nil
+	}
+
+	pods, _, err := GetPods(Config{Address: address}, nil)
+	assert.Nil(t, err)
+	assert.Len(t, pods, 1)
+	// the spawned api-service is stopped once done
+	assert.True(t, svc.stopped)
+}
+
+func TestSpawnFail(t *testing.T) {
+	address := freeAddress(t)
+
+	spawnApi = func(string) (apiService, error) {
+		return nil, errors.New("could not find rkt in PATH")
 	}
+	_, _, err := GetPods(Config{Address: address}, nil)
+	assert.EqualError(t, err, "could not find rkt in PATH")
 
-	GetPods()
+	// exits, e.g. without permission to read the pods
+	svc := &fakeService{done: make(chan struct{})}
+	close(svc.done)
+	spawnApi = func(string) (apiService, error) { return svc, nil }
+	_, _, err = GetPods(Config{Address: address}, nil)
+	assert.EqualError(t, err, "rkt api-service exited: <nil>")
 
-	assert.True(t, startCalled)
-	assert.False(t, closedCalled)
-	assert.False(t, podsCalled)
+	// never answers
+	svc = &fakeService{done: make(chan struct{})}
+	start := time.Now()
+	_, _, err = GetPods(Config{Address: address, StartTimeout: 500 * time.Millisecond}, nil)
+	assert.Contains(t, err.Error(), "rkt api-service didn't answer at "+address+" within 500ms")
+	assert.True(t, time.Since(start) < 5*time.Second)
+	assert.True(t, svc.stopped)
 }
 
-func TestSuccess(t *testing.T) {
-	// tests that if startApi() succeeds, other functions are properly called
-	startCalled := false
-	closedCalled := false
-	podsCalled := false
+func TestProcess(t *testing.T) {
+	p, err := startProcess("sleep", "60")
+	if err != nil {
+		t.Skip(err)
+	}
+	start := time.Now()
+	assert.Nil(t, p.Stop())
+	assert.True(t, time.Since(start) < stopTimeout)
 
-	startApi = func() error {
-		startCalled = true
-		return nil
+	// reaped
+	select {
+	case <-p.Exited():
+	default:
+		t.Error("process not waited for")
 	}
+	assert.NotNil(t, p.cmd.ProcessState)
+
+	p, _ = startProcess("false")
+	<-p.Exited()
+	assert.NotNil(t, p.Stop())
+}
 
-	closeApi = func() error {
-		closedCalled = true
-		return nil
+func TestScrubManifest(t *testing.T) {
+	viper.Set("danger", true)
+	defer viper.Set("danger", false)
+	manifest := `{"acKind": "PodManifest", "apps": [{"name": "etcd", "app": {"exec": ["/etcd"], "environment": [
+		{"name": "ETCD_NAME", "value": "node1"}, {"name": "ETCD_PEER_KEY", "value": "abc"}]}}]}`
+	grpcpod := v1alpha.Pod{Id: "abc123", Manifest: []byte(manifest)}
+	p := Pod{Pod: &grpcpod}
+
+	var m struct {
+		Apps []struct {
+			App struct {
+				Environment []struct{ Name, Value string }
+			}
+		}
 	}
+	assert.Nil(t, json.Unmarshal(scrubManifest([]byte(manifest), p.env), &m))
+	assert.Equal(t, m.Apps[0].App.Environment[0].Value, "node1")
+	// secrets are redacted even in danger mode
+	assert.Equal(t, m.Apps[0].App.Environment[1].Value, "scrubbed by mayday")
+
+	viper.Set("danger", false)
+	assert.Nil(t, json.Unmarshal(scrubManifest([]byte(manifest), p.env), &m))
+	assert.Equal(t, m.Apps[0].App.Environment[0].Value, "scrubbed by mayday")
+	// the pod itself isn't changed
+	p.Content()
+	assert.Equal(t, string(grpcpod.Manifest), manifest)
 
-	podsFromApi = func() ([]*v1alpha.Pod, error) {
-		podsCalled = true
+	// a manifest that can't be scrubbed isn't stored
+	grpcpod.Manifest = []byte(`{"apps": [{"app": {"environment": [{"name": "ETCD_PEER_KEY", "value": "abc"}`)
+	p = Pod{Pod: &grpcpod}
+	assert.Equal(t, scrubManifest(grpcpod.Manifest, p.env), errUnrecognizedManifest)
+	assert.Contains(t, p.Content().String(), `"manifest": "unrecognized manifest format"`)
+	assert.NotContains(t, p.Content().String(), "ETCD_PEER_KEY")
+}
+
+func TestEvents(t *testing.T) {
+	server := &fakeServer{events: []*v1alpha.Event{
+		{Type: v1alpha.EventType_EVENT_TYPE_APP_EXITED, Id: "abc123", From: "etcd", Time: 1488326401,
+			Data: []*v1alpha.KeyValue{{Key: "exit_code", Value: "1"}, {Key: "app", Value: "etcd"}}},
+		{Type: v1alpha.EventType_EVENT_TYPE_APP_STARTED, Id: "abc123", From: "etcd", Time: 1488326402},
+	}}
+	address, stop := serve(t, server, "")
+	defer stop()
+	spawnApi = func(address string) (apiService, error) {
+		t.Fatal("api-service spawned")
 		return nil, nil
 	}
+	now = func() time.Time { return time.Unix(1488326400, 0) }
+	defer func() { now = time.Now }()
 
-	_, _, err := GetPods()
-	assert.Nil(t, err)
+	// listens until the events are stored
+	c := NewEventCollector(Config{Address: address})
+	c.Start(time.Time{})
+	time.Sleep(200 * time.Millisecond)
+	outputs := c.Outputs()
+	assert.Equal(t, outputs[0].Name(), "/rkt/events")
+	assert.Equal(t, outputs[0].Link(), "rkt_events")
+	assert.Equal(t, outputs[0].Content().String(), `Events since 2017-03-01T00:00:00Z
+
+TIME                  TYPE         ID      FROM  DATA
+2017-03-01T00:00:01Z  APP_EXITED   abc123  etcd  app=etcd exit_code=1
+2017-03-01T00:00:02Z  APP_STARTED  abc123  etcd  
+`)
+	assert.Contains(t, outputs[1].Content().String(), `"type": "APP_EXITED"`)
+	assert.Contains(t, outputs[1].Content().String(), `"exit_code": "1"`)
+	assert.EqualValues(t, server.since, 1488326400)
+
+	// or for the window, bounded by the deadline
+	now = time.Now
+	c = NewEventCollector(Config{Address: address, Events: Events{Enabled: true, Window: time.Hour}})
+	start := time.Now()
+	c.Start(start.Add(300 * time.Millisecond))
+	assert.Len(t, c.wait(), 2)
+	assert.True(t, time.Since(start) < 5*time.Second)
+	assert.Nil(t, c.err)
+
+	// stopped early
+	stop()
+	c = NewEventCollector(Config{Address: freeAddress(t)})
+	spawnApi = func(string) (apiService, error) {
+		return nil, errors.New("could not find rkt in PATH")
+	}
+	c.Start(time.Time{})
+	assert.Contains(t, c.Outputs()[0].Content().String(), "stopped listening: could not find rkt in PATH")
+}
+
+func TestContainers(t *testing.T) {
+	viper.Set("danger", true)
+	defer viper.Set("danger", false)
+
+	pods := []*Pod{
+		{Pod: &v1alpha.Pod{
+			Id: "abc123", State: v1alpha.PodState_POD_STATE_RUNNING, CreatedAt: 1488326400000000000, StartedAt: 1488326401500000000,
+			Networks: []*v1alpha.Network{{Name: "default", Ipv4: "172.16.28.2"}},
+			Apps: []*v1alpha.App{
+				{Name: "etcd", State: v1alpha.AppState_APP_STATE_RUNNING, Image: &v1alpha.Image{Name: "coreos.com/etcd", Version: "v3.1.0"}},
+				{Name: "init", State: v1alpha.AppState_APP_STATE_EXITED, ExitCode: 2},
+			},
+			Manifest: []byte(`{"acKind": "PodManifest",
+				"apps": [{"name": "etcd", "mounts": [{"volume": "data", "path": "/var/lib/etcd"}, {"volume": "tmp", "path": "/tmp"}]}],
+				"volumes": [{"name": "data", "kind": "host", "source": "/srv/etcd", "readOnly": true}, {"name": "tmp", "kind": "empty"}]}`),
+		}},
+		// listed but not inspected
+		{Pod: &v1alpha.Pod{Id: "def456", State: v1alpha.PodState_POD_STATE_PREPARED}},
+	}
+
+	cs := Containers(pods)
+	assert.Len(t, cs, 3)
+
+	etcd := cs[0]
+	assert.Equal(t, etcd.Runtime, "rkt")
+	assert.Equal(t, etcd.Id, "abc123/etcd")
+	assert.Equal(t, etcd.Pod, "abc123")
+	assert.Equal(t, etcd.Name, "etcd")
+	assert.Equal(t, etcd.Image, "coreos.com/etcd:v3.1.0")
+	assert.Equal(t, etcd.State, "running")
+	assert.Equal(t, etcd.Created.Format(time.RFC3339Nano), "2017-03-01T00:00:00Z")
+	assert.Equal(t, etcd.Started.Format(time.RFC3339Nano), "2017-03-01T00:00:01.5Z")
+	assert.Equal(t, etcd.Networks, []containers.Network{{Name: "default", IPv4: "172.16.28.2"}})
+	assert.Equal(t, etcd.Mounts, []containers.Mount{
+		{Source: "/srv/etcd", Destination: "/var/lib/etcd", ReadOnly: true},
+		{Source: "tmp", Destination: "/tmp"},
+	})
+	assert.Equal(t, etcd.Logs, "rkt/abc123/etcd.log")
+	assert.Equal(t, etcd.Dump, "rkt/abc123/pod.json")
+
+	assert.Equal(t, cs[1].State, "exited")
+	assert.Equal(t, cs[1].ExitCode, 2)
+	assert.Len(t, cs[1].Mounts, 0)
 
-	assert.True(t, startCalled)
-	assert.True(t, closedCalled)
-	assert.True(t, podsCalled)
+	assert.Equal(t, cs[2].Id, "def456")
+	assert.Equal(t, cs[2].State, "created")
+	// not run, so without logs
+	assert.Equal(t, cs[2].Logs, "")
 }
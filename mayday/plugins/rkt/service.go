@@ -0,0 +1,158 @@
+package rkt
+
+import (
+	"fmt"
+	"log"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"github.com/coreos/mayday/mayday/plugins/rkt/v1alpha"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultAddress      = "localhost:15441"
+	defaultStartTimeout = 10 * time.Second
+
+	// probeTimeout bounds each attempt to reach the API service
+	probeTimeout = 500 * time.Millisecond
+	pollInterval = 100 * time.Millisecond
+	// stopTimeout is how long a spawned api-service gets to exit before it's
+	// killed
+	stopTimeout = 5 * time.Second
+)
+
+type Config struct {
+	Address      string        `mapstructure:"address"`       // of the api-service, spawned there when none answers
+	StartTimeout time.Duration `mapstructure:"start_timeout"` // how long a spawned api-service gets to answer
+	Logs         Logs          `mapstructure:"logs"`          // collected with --danger
+	Events       Events        `mapstructure:"events"`
+}
+
+func (c Config) withDefaults() Config {
+	if c.Address == "" {
+		c.Address = defaultAddress
+	}
+	if c.StartTimeout == 0 {
+		c.StartTimeout = defaultStartTimeout
+	}
+	return c
+}
+
+// dialApi connects to the API service at address, and checks that it answers
+var dialApi = func(address string) (v1alpha.PublicAPIClient, func() error, error) {
+	conn, err := grpc.Dial(address, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(probeTimeout))
+	if err != nil {
+		return nil, nil, err
+	}
+	c := v1alpha.NewPublicAPIClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+	if _, err := c.GetInfo(ctx, &v1alpha.GetInfoRequest{}); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return c, conn.Close, nil
+}
+
+// apiService is an api-service started by mayday
+type apiService interface {
+	Exited() <-chan struct{} // closed once it has exited
+	Stop() error
+}
+
+// spawnApi starts rkt api-service listening on address
+var spawnApi = func(address string) (apiService, error) {
+	p, err := exec.LookPath("rkt")
+	if err != nil {
+		log.Println("could not find rkt in PATH")
+		return nil, err
+	}
+	return startProcess(p, "api-service", "--listen="+address)
+}
+
+// connect returns a client of the API service at the configured address,
+// spawning an api-service when none answers there. stop closes the connection
+// and stops the api-service if mayday spawned it.
+func connect(conf Config) (v1alpha.PublicAPIClient, func(), error) {
+	if c, closeConn, err := dialApi(conf.Address); err == nil {
+		log.Printf("Using the rkt api-service at %s", conf.Address)
+		return c, func() { closeConn() }, nil
+	}
+
+	log.Printf("Starting rkt api-service at %s", conf.Address)
+	svc, err := spawnApi(conf.Address)
+	if err != nil {
+		return nil, nil, err
+	}
+	stop := func() {
+		if err := svc.Stop(); err != nil {
+			log.Printf("error stopping rkt api-service: %s", err)
+		}
+	}
+
+	deadline := now().Add(conf.StartTimeout)
+	for {
+		c, closeConn, err := dialApi(conf.Address)
+		if err == nil {
+			return c, func() { closeConn(); stop() }, nil
+		}
+
+		select {
+		case <-svc.Exited():
+			// e.g. not allowed to read the pods
+			return nil, nil, fmt.Errorf("rkt api-service exited: %v", svc.Stop())
+		default:
+		}
+		if now().After(deadline) {
+			stop()
+			return nil, nil, fmt.Errorf("rkt api-service didn't answer at %s within %s: %s", conf.Address, conf.StartTimeout, err)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// process is a child process, waited for as soon as it exits
+type process struct {
+	cmd  *exec.Cmd
+	done chan struct{}
+	err  error // why it exited, once done is closed
+}
+
+func startProcess(path string, args ...string) (*process, error) {
+	p := &process{cmd: exec.Command(path, args...), done: make(chan struct{})}
+	if err := p.cmd.Start(); err != nil {
+		return nil, err
+	}
+	go func() {
+		p.err = p.cmd.Wait()
+		close(p.done)
+	}()
+	return p, nil
+}
+
+func (p *process) Exited() <-chan struct{} {
+	return p.done
+}
+
+// Stop terminates the process, killing it if it doesn't exit in time, and
+// returns why it exited unless it was stopped
+func (p *process) Stop() error {
+	select {
+	case <-p.done:
+		return p.err
+	default:
+	}
+
+	p.cmd.Process.Signal(syscall.SIGTERM)
+	select {
+	case <-p.done:
+	case <-time.After(stopTimeout):
+		p.cmd.Process.Kill()
+		<-p.done
+	}
+	return nil
+}
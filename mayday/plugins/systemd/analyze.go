@@ -0,0 +1,212 @@
+package systemd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/coreos/mayday/mayday/scrub"
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+const outputDir = "/systemd/"
+
+func renderJSON(v interface{}) []byte {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		log.Printf("error marshalling systemd report: %s", err)
+		return []byte("json marshal error")
+	}
+	return b
+}
+
+// Reports analyzes the manager and the units: the manager state, the time
+// each unit took to start (as systemd-analyze blame), the chain of units the
+// default target waited for (as systemd-analyze critical-chain), the failed
+// units and the unit files overriding vendor unit files (as systemd-delta).
+// The manager may be nil if it couldn't be read. Environment settings are
+// scrubbed by env.
+func Reports(m *ManagerState, units []*Unit, env *scrub.Scrubber) []*tarable.Output {
+	var userspace uint64
+	if m != nil {
+		userspace = usec(m.Properties, "UserspaceTimestampMonotonic")
+	}
+	failed := failedUnits(units)
+
+	reports := []*tarable.Output{
+		tarable.NewOutput(outputDir, "blame", "systemd_blame", func() []byte { return blame(units) }),
+		tarable.NewOutput(outputDir, "critical-chain", "", func() []byte { return criticalChain(units, userspace) }),
+		tarable.NewOutput(outputDir, "failed", "systemd_failed", func() []byte { return failedText(failed) }),
+		tarable.NewOutput(outputDir, "failed.json", "", func() []byte { return renderJSON(failed) }),
+		tarable.NewOutput(outputDir, "delta", "systemd_delta", func() []byte { return deltaText(delta(root, env)) }),
+	}
+	if m != nil {
+		reports = append(reports, tarable.NewOutput(outputDir, "manager.json", "", func() []byte { return renderJSON(m) }))
+	}
+	return reports
+}
+
+// formatSpan formats a duration the way systemd-analyze does, e.g. "1min
+// 2.345s" or "120ms"
+func formatSpan(usec uint64) string {
+	d := time.Duration(usec) * time.Microsecond
+	switch {
+	case d >= time.Minute:
+		return fmt.Sprintf("%dmin %.3fs", int(d/time.Minute), (d % time.Minute).Seconds())
+	case d >= time.Second:
+		return fmt.Sprintf("%.3fs", d.Seconds())
+	default:
+		return fmt.Sprintf("%dms", int(d/time.Millisecond))
+	}
+}
+
+// times returns when a unit started activating and when it became active,
+// in microseconds since boot, or zero
+func times(u *Unit) (activating, activated uint64) {
+	props := u.state.Properties["unit"]
+	return usec(props, "InactiveExitTimestampMonotonic"), usec(props, "ActiveEnterTimestampMonotonic")
+}
+
+func blame(units []*Unit) []byte {
+	type entry struct {
+		name string
+		took uint64
+	}
+	var entries []entry
+	for _, u := range units {
+		activating, activated := times(u)
+		if activating > 0 && activated > activating {
+			entries = append(entries, entry{u.state.Name, activated - activating})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].took > entries[j].took })
+
+	var b bytes.Buffer
+	for _, e := range entries {
+		fmt.Fprintf(&b, "%16s %s\n", formatSpan(e.took), e.name)
+	}
+	return b.Bytes()
+}
+
+const maxChainDepth = 64
+
+// criticalChain follows, from the default target, the After= dependency that
+// became active last
+func criticalChain(units []*Unit, userspace uint64) []byte {
+	byName := make(map[string]*Unit)
+	var start *Unit
+	for _, u := range units {
+		byName[u.state.Name] = u
+		names, _ := u.state.Properties["unit"]["Names"].([]string)
+		for _, n := range names {
+			if n == "default.target" {
+				start = u
+			}
+		}
+	}
+	if start == nil {
+		start = byName["multi-user.target"]
+	}
+
+	var b bytes.Buffer
+	b.WriteString("The time when unit became active or started is printed after the \"@\" character.\n")
+	b.WriteString("The time the unit took to start is printed after the \"+\" character.\n\n")
+	if start == nil {
+		b.WriteString("default.target not found\n")
+		return b.Bytes()
+	}
+
+	visited := make(map[string]bool)
+	for u, depth := start, 0; u != nil && depth < maxChainDepth; depth++ {
+		visited[u.state.Name] = true
+		activating, activated := times(u)
+
+		prefix := ""
+		if depth > 0 {
+			prefix = strings.Repeat("  ", depth-1) + "└─"
+		}
+		fmt.Fprintf(&b, "%s%s", prefix, u.state.Name)
+		if activated > userspace {
+			fmt.Fprintf(&b, " @%s", formatSpan(activated-userspace))
+		}
+		if activating > 0 && activated > activating {
+			fmt.Fprintf(&b, " +%s", formatSpan(activated-activating))
+		}
+		b.WriteString("\n")
+
+		// the dependency that became active last held this unit up
+		var next *Unit
+		var latest uint64
+		after, _ := u.state.Properties["unit"]["After"].([]string)
+		for _, name := range after {
+			dep, ok := byName[name]
+			if !ok || visited[name] {
+				continue
+			}
+			if _, t := times(dep); t > latest && (activated == 0 || t <= activated) {
+				next, latest = dep, t
+			}
+		}
+		u = next
+	}
+	return b.Bytes()
+}
+
+// FailedUnit is a unit in the failed state and why it failed
+type FailedUnit struct {
+	Name       string    `json:"name"`
+	SubState   string    `json:"sub_state"`
+	Result     string    `json:"result,omitempty"` // e.g. exit-code, signal, timeout
+	ExecCode   int32     `json:"exec_main_code,omitempty"`
+	ExecStatus int32     `json:"exec_main_status,omitempty"`
+	Restarts   uint32    `json:"restarts,omitempty"`
+	Since      time.Time `json:"since"`
+}
+
+func failedUnits(units []*Unit) []FailedUnit {
+	failed := []FailedUnit{}
+	for _, u := range units {
+		props := u.state.Properties["unit"]
+		if props["ActiveState"] != "failed" {
+			continue
+		}
+		f := FailedUnit{Name: u.state.Name}
+		f.SubState, _ = props["SubState"].(string)
+		if t := usec(props, "InactiveEnterTimestamp"); t > 0 {
+			f.Since = time.Unix(0, int64(t)*int64(time.Microsecond)).UTC()
+		}
+		// the result is a property of the unit type
+		for iface, typeProps := range u.state.Properties {
+			if iface == "unit" {
+				continue
+			}
+			f.Result, _ = typeProps["Result"].(string)
+			f.ExecCode, _ = typeProps["ExecMainCode"].(int32)
+			f.ExecStatus, _ = typeProps["ExecMainStatus"].(int32)
+			f.Restarts, _ = typeProps["NRestarts"].(uint32)
+		}
+		failed = append(failed, f)
+	}
+	return failed
+}
+
+func failedText(failed []FailedUnit) []byte {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "UNIT\tSUB\tRESULT\tSTATUS\tRESTARTS\tSINCE")
+	for _, f := range failed {
+		since := "-"
+		if !f.Since.IsZero() {
+			since = f.Since.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\n", f.Name, f.SubState, f.Result, f.ExecStatus, f.Restarts, since)
+	}
+	w.Flush()
+	fmt.Fprintf(&b, "\n%d failed units\n", len(failed))
+	return b.Bytes()
+}
@@ -0,0 +1,215 @@
+package systemd
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/coreos/mayday/mayday/scrub"
+)
+
+// root is the directory the unit directories are found under
+var root = "/"
+
+// UnitDirs are the directories systemd loads units from, most important
+// first
+var UnitDirs = []string{
+	"/etc/systemd/system",
+	"/run/systemd/system",
+	"/usr/local/lib/systemd/system",
+	"/usr/lib/systemd/system",
+	"/lib/systemd/system",
+}
+
+// Override is a unit file or drop-in that changes a unit shipped elsewhere,
+// as systemd-delta reports it
+type Override struct {
+	Kind       string `json:"kind"`       // masked, redirected, overridden, equivalent or extended
+	Path       string `json:"path"`       // the file taking effect
+	Overridden string `json:"overridden"` // the unit file it changes
+	Diff       string `json:"diff,omitempty"`
+}
+
+type unitFileInfo struct {
+	path string
+	link string // symlink target, if a symlink
+}
+
+// delta compares the unit directories under root
+func delta(root string, env *scrub.Scrubber) []Override {
+	// units and drop-ins by unit name, most important first
+	files := make(map[string][]unitFileInfo)
+	dropIns := make(map[string][]string)
+
+	seen := make(map[string]bool)
+	for _, dir := range UnitDirs {
+		path := filepath.Join(root, dir)
+		// e.g. /lib is a symlink to /usr/lib
+		real, err := filepath.EvalSymlinks(path)
+		if err != nil || seen[real] {
+			continue
+		}
+		seen[real] = true
+
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			name := e.Name()
+			full := filepath.Join(path, name)
+			switch {
+			case e.IsDir() && strings.HasSuffix(name, ".d"):
+				confs, _ := filepath.Glob(filepath.Join(full, "*.conf"))
+				unit := strings.TrimSuffix(name, ".d")
+				dropIns[unit] = append(dropIns[unit], confs...)
+			case e.IsDir():
+				// .wants and .requires directories
+			case e.Mode()&os.ModeSymlink != 0:
+				target, _ := os.Readlink(full)
+				files[name] = append(files[name], unitFileInfo{path: full, link: target})
+			default:
+				files[name] = append(files[name], unitFileInfo{path: full})
+			}
+		}
+	}
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	for name := range dropIns {
+		if _, ok := files[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var overrides []Override
+	for _, name := range names {
+		fs := files[name]
+		if len(fs) > 1 {
+			top := fs[0]
+			for _, lower := range fs[1:] {
+				overrides = append(overrides, compare(root, top, lower, env))
+			}
+		}
+		for _, conf := range dropIns[name] {
+			o := Override{Kind: "extended", Path: strip(root, conf)}
+			if len(fs) > 0 {
+				o.Overridden = strip(root, fs[len(fs)-1].path)
+			}
+			overrides = append(overrides, o)
+		}
+	}
+	return overrides
+}
+
+// strip removes root from a path, to report paths as they are on the host
+func strip(root, path string) string {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return path
+	}
+	return "/" + rel
+}
+
+func compare(root string, top, lower unitFileInfo, env *scrub.Scrubber) Override {
+	o := Override{Path: strip(root, top.path), Overridden: strip(root, lower.path)}
+	switch {
+	case top.link == "/dev/null":
+		o.Kind = "masked"
+		return o
+	case top.link != "":
+		o.Kind = "redirected"
+		return o
+	}
+
+	a, errA := readFile(lower.path)
+	b, errB := readFile(top.path)
+	if errA != nil || errB != nil {
+		o.Kind = "overridden"
+		return o
+	}
+	if bytes.Equal(a, b) {
+		o.Kind = "equivalent"
+		return o
+	}
+	o.Kind = "overridden"
+	o.Diff = diff(RedactUnitFile(string(a), env), RedactUnitFile(string(b), env))
+	return o
+}
+
+// diff returns the lines of a and b, marking the ones only in a with "-" and
+// the ones only in b with "+"
+func diff(a, b string) string {
+	x := strings.Split(strings.TrimSuffix(a, "\n"), "\n")
+	y := strings.Split(strings.TrimSuffix(b, "\n"), "\n")
+
+	// longest common subsequence, from the end
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			out.WriteString(" " + x[i] + "\n")
+			i++
+			j++
+		case j < len(y) && (i == len(x) || lcs[i][j+1] > lcs[i+1][j]):
+			out.WriteString("+" + y[j] + "\n")
+			j++
+		default:
+			out.WriteString("-" + x[i] + "\n")
+			i++
+		}
+	}
+	return out.String()
+}
+
+func deltaText(overrides []Override) []byte {
+	var b bytes.Buffer
+	counts := make(map[string]int)
+	for _, o := range overrides {
+		counts[o.Kind]++
+		fmt.Fprintf(&b, "[%s] %s", strings.ToUpper(o.Kind), o.Path)
+		if o.Overridden != "" {
+			fmt.Fprintf(&b, " → %s", o.Overridden)
+		}
+		b.WriteString("\n")
+		if o.Diff != "" {
+			b.WriteString("\n" + o.Diff + "\n")
+		}
+	}
+
+	var kinds []string
+	for k := range counts {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	b.WriteString("\n")
+	for _, k := range kinds {
+		fmt.Fprintf(&b, "%d %s\n", counts[k], k)
+	}
+	fmt.Fprintf(&b, "%d overridden configuration files found.\n", len(overrides))
+	return b.Bytes()
+}
@@ -0,0 +1,100 @@
+package systemd
+
+import (
+	"github.com/coreos/go-systemd/dbus"
+	"github.com/coreos/mayday/mayday/scrub"
+	godbus "github.com/godbus/dbus"
+)
+
+const (
+	managerDest  = "org.freedesktop.systemd1"
+	managerPath  = godbus.ObjectPath("/org/freedesktop/systemd1")
+	managerIface = "org.freedesktop.systemd1.Manager"
+)
+
+// busConn adds the manager calls go-systemd doesn't provide
+type busConn struct {
+	*dbus.Conn
+	bus *godbus.Conn
+}
+
+func dial() (conn, error) {
+	c, err := dbus.New()
+	if err != nil {
+		return nil, err
+	}
+	// the shared system bus connection, which must not be closed
+	bus, err := godbus.SystemBus()
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	return &busConn{Conn: c, bus: bus}, nil
+}
+
+func (c *busConn) GetManagerProperties() (map[string]interface{}, error) {
+	var props map[string]godbus.Variant
+	obj := c.bus.Object(managerDest, managerPath)
+	if err := obj.Call("org.freedesktop.DBus.Properties.GetAll", 0, managerIface).Store(&props); err != nil {
+		return nil, err
+	}
+	out := make(map[string]interface{}, len(props))
+	for k, v := range props {
+		out[k] = v.Value()
+	}
+	return out, nil
+}
+
+func (c *busConn) ListJobs() ([]Job, error) {
+	var jobs []Job
+	obj := c.bus.Object(managerDest, managerPath)
+	if err := obj.Call(managerIface+".ListJobs", 0).Store(&jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// Job is a queued job, as systemctl list-jobs shows it
+type Job struct {
+	ID       uint32            `json:"id"`
+	Unit     string            `json:"unit"`
+	Type     string            `json:"type"`
+	State    string            `json:"state"`
+	Path     godbus.ObjectPath `json:"path"`
+	UnitPath godbus.ObjectPath `json:"unit_path"`
+}
+
+// ManagerState is what the systemd manager reports about itself
+type ManagerState struct {
+	Properties map[string]interface{} `json:"properties"` // Version, Features, Environment, NJobs, ...
+	Jobs       []Job                  `json:"jobs"`
+}
+
+// Manager reads the properties and job queue of the systemd manager, with its
+// environment scrubbed by env
+func Manager(env *scrub.Scrubber) (*ManagerState, error) {
+	c, err := newConn()
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	props, err := c.GetManagerProperties()
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := c.ListJobs()
+	if err != nil {
+		return nil, err
+	}
+	if jobs == nil {
+		jobs = []Job{}
+	}
+	return &ManagerState{Properties: clean(props, env), Jobs: jobs}, nil
+}
+
+// usec returns a timestamp property, in microseconds
+func usec(props map[string]interface{}, name string) uint64 {
+	v, _ := props[name].(uint64)
+	return v
+}
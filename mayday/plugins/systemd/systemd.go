@@ -0,0 +1,241 @@
+// Package systemd collects the state of systemd and of its units over D-Bus.
+package systemd
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/coreos/go-systemd/dbus"
+	"github.com/coreos/mayday/mayday/scrub"
+	"github.com/coreos/mayday/mayday/tarable"
+)
+
+// conn is the part of the systemd D-Bus API that mayday uses
+type conn interface {
+	ListUnits() ([]dbus.UnitStatus, error)
+	GetUnitProperties(unit string) (map[string]interface{}, error)
+	GetUnitTypeProperties(unit string, unitType string) (map[string]interface{}, error)
+	GetManagerProperties() (map[string]interface{}, error)
+	ListJobs() ([]Job, error)
+	Close()
+}
+
+var newConn = dial
+
+var readFile = ioutil.ReadFile
+
+// File is a unit file or drop-in
+type File struct {
+	Path    string `json:"path"`
+	Content string `json:"content,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// UnitState is everything systemd reports about a unit
+type UnitState struct {
+	Name string `json:"name"`
+	// properties by interface: "unit" for the properties every unit has,
+	// and e.g. "service" for the properties of its type
+	Properties map[string]map[string]interface{} `json:"properties"`
+	UnitFile   *File                             `json:"unit_file,omitempty"`
+	DropIns    []File                            `json:"drop_ins"`
+	Errors     []string                          `json:"errors,omitempty"`
+}
+
+type Unit struct {
+	state   *UnitState
+	content *bytes.Buffer
+}
+
+// List reads the state of every unit systemd has loaded. Their environments
+// are scrubbed by env.
+func List(env *scrub.Scrubber) ([]*Unit, error) {
+	c, err := newConn()
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	statuses, err := c.ListUnits()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Collecting the state of %d systemd units", len(statuses))
+	var units []*Unit
+	for _, s := range statuses {
+		units = append(units, &Unit{state: unitState(c, s.Name, env)})
+	}
+	return units, nil
+}
+
+// unitType returns the D-Bus interface suffix of a unit's type, e.g.
+// "Service" for "etcd.service"
+func unitType(name string) string {
+	i := strings.LastIndex(name, ".")
+	if i < 0 || i == len(name)-1 {
+		return ""
+	}
+	return strings.ToUpper(name[i+1:i+2]) + name[i+2:]
+}
+
+func unitState(c conn, name string, env *scrub.Scrubber) *UnitState {
+	st := &UnitState{
+		Name:       name,
+		Properties: make(map[string]map[string]interface{}),
+		DropIns:    []File{},
+	}
+
+	props, err := c.GetUnitProperties(name)
+	if err != nil {
+		st.Errors = append(st.Errors, err.Error())
+		return st
+	}
+	st.Properties["unit"] = clean(props, env)
+
+	// targets and devices have no properties of their own
+	if typ := unitType(name); typ != "" && typ != "Target" && typ != "Device" {
+		if props, err := c.GetUnitTypeProperties(name, typ); err == nil {
+			st.Properties[strings.ToLower(typ)] = clean(props, env)
+		} else {
+			st.Errors = append(st.Errors, err.Error())
+		}
+	}
+
+	if path, _ := props["FragmentPath"].(string); path != "" {
+		f := unitFile(path, env)
+		st.UnitFile = &f
+	}
+	dropIns, _ := props["DropInPaths"].([]string)
+	for _, path := range dropIns {
+		st.DropIns = append(st.DropIns, unitFile(path, env))
+	}
+	return st
+}
+
+func unitFile(path string, env *scrub.Scrubber) File {
+	f := File{Path: path}
+	b, err := readFile(path)
+	if err != nil {
+		f.Error = err.Error()
+		return f
+	}
+	f.Content = RedactUnitFile(string(b), env)
+	return f
+}
+
+// RedactUnitFile scrubs the values of the assignments of Environment= lines
+// of a unit file by env, keeping the names they set
+func RedactUnitFile(content string, env *scrub.Scrubber) string {
+	lines := strings.Split(content, "\n")
+	for i, l := range lines {
+		trimmed := strings.TrimSpace(l)
+		if !strings.HasPrefix(trimmed, "Environment=") {
+			continue
+		}
+		vars := env.Env(unitWords(strings.TrimPrefix(trimmed, "Environment=")))
+		for j, v := range vars {
+			vars[j] = quoteWord(v)
+		}
+		lines[i] = "Environment=" + strings.Join(vars, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// unitWords splits the value of a unit file setting into its words, which are
+// separated by spaces and may be quoted, as in Environment="A=one two" B=three
+func unitWords(s string) []string {
+	var words []string
+	var w bytes.Buffer
+	inWord := false
+	var quote rune
+	rs := []rune(s)
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case r == '\\' && i+1 < len(rs):
+			i++
+			w.WriteRune(rs[i])
+			inWord = true
+		case quote != 0:
+			w.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, w.String())
+				w.Reset()
+				inWord = false
+			}
+		default:
+			w.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, w.String())
+	}
+	return words
+}
+
+// quoteWord quotes a word of a unit file setting that holds spaces, quotes or
+// backslashes
+func quoteWord(w string) string {
+	if !strings.ContainsAny(w, " \t\"'\\") {
+		return w
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(w) + `"`
+}
+
+// clean makes property values readable as JSON: byte arrays (such as
+// InvocationID) become hex and environments are scrubbed by env
+func clean(props map[string]interface{}, env *scrub.Scrubber) map[string]interface{} {
+	out := make(map[string]interface{}, len(props))
+	for k, v := range props {
+		switch v := v.(type) {
+		case []byte:
+			out[k] = hex.EncodeToString(v)
+		case []string:
+			if k == "Environment" {
+				out[k] = env.Env(v)
+			} else {
+				out[k] = v
+			}
+		default:
+			out[k] = v
+		}
+	}
+	return out
+}
+
+func (u *Unit) Content() *bytes.Buffer {
+	if u.content == nil {
+		b, err := json.MarshalIndent(u.state, "", "  ")
+		if err != nil {
+			log.Printf("error marshalling the state of %s: %s", u.state.Name, err)
+			b = []byte("json marshal error")
+		}
+		u.content = bytes.NewBuffer(b)
+	}
+	return u.content
+}
+
+func (u *Unit) Header() *tar.Header {
+	return tarable.Header(u.Content(), u.Name())
+}
+
+func (u *Unit) Name() string {
+	return "/systemd/units/" + u.state.Name + ".json"
+}
+
+func (u *Unit) Link() string {
+	return ""
+}
@@ -31,12 +31,30 @@ func (t *Tar) Add(tb tarable.Tarable) error {
 	header := tb.Header()
 	header.Name = t.subdir + "/" + strings.TrimPrefix(header.Name, "/")
 
+	var content io.Reader
+	if s, ok := tb.(tarable.Streamer); ok {
+		r, err := s.Open()
+		if err != nil {
+			log.Printf("error opening %s: %s", tb.Name(), err)
+			return err
+		}
+		defer r.Close()
+		content = io.LimitReader(r, header.Size)
+	} else {
+		content = tb.Content()
+	}
+
 	if err = t.tw.WriteHeader(header); err != nil {
 		log.Printf("error writing header: %s", err)
 		return err
 	}
 
-	_, err = io.Copy(t.tw, tb.Content())
+	n, err := io.Copy(t.tw, content)
+	if err == nil && n < header.Size {
+		// a streamed file shrank after its header was made, pad it to keep
+		// the archive readable
+		_, err = t.tw.Write(make([]byte, header.Size-n))
+	}
 
 	if err != nil {
 		return fmt.Errorf("could not copy file: %v", err)
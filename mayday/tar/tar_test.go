@@ -5,6 +5,8 @@ import (
 	"bytes"
 	"compress/gzip"
 	"io"
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -59,6 +61,52 @@ func TestContentsAdded(t *testing.T) {
 	assert.Equal(t, newbuf.String(), "test_content")
 }
 
+// StreamedTarable claims more content than its reader has
+type StreamedTarable struct {
+	TestTarable
+}
+
+func (st *StreamedTarable) Header() *tar.Header {
+	h := st.TestTarable.Header()
+	h.Size = 16
+	return h
+}
+
+func (st *StreamedTarable) Open() (io.ReadCloser, error) {
+	return ioutil.NopCloser(strings.NewReader("streamed")), nil
+}
+
+func TestStreamedAdded(t *testing.T) {
+	buf := new(bytes.Buffer)
+	var tf Tar
+	tf.Init(buf, "basepath")
+
+	assert.Nil(t, tf.Add(&StreamedTarable{}))
+	assert.Nil(t, tf.Add(&TestTarable{}))
+	tf.Close()
+
+	gr, err := gzip.NewReader(buf)
+	if err != nil {
+		panic(err)
+	}
+	tr := tar.NewReader(gr)
+
+	var contents []string
+	for {
+		_, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			panic(err)
+		}
+		b, _ := ioutil.ReadAll(tr)
+		contents = append(contents, string(b))
+	}
+	// the missing bytes are padded, and don't shift the next file
+	assert.Equal(t, contents, []string{"streamed\x00\x00\x00\x00\x00\x00\x00\x00", "test_content"})
+}
+
 func TestLinkAdded(t *testing.T) {
 	buf := new(bytes.Buffer)
 	var tf Tar
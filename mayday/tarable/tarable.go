@@ -3,6 +3,7 @@ package tarable
 import (
 	"archive/tar"
 	"bytes"
+	"io"
 	"time"
 )
 
@@ -13,6 +14,13 @@ type Tarable interface {
 	Link() string // short link to file in archive
 }
 
+// Streamer is implemented by tarables too large to hold in memory: when the
+// archive is written their content is copied from Open, up to the size in
+// their header, instead of from Content
+type Streamer interface {
+	Open() (io.ReadCloser, error)
+}
+
 // the default implementation of Header()
 func Header(content *bytes.Buffer, name string) *tar.Header {
 
@@ -25,3 +33,43 @@ func Header(content *bytes.Buffer, name string) *tar.Header {
 
 	return header
 }
+
+// Output is a file of a plugin, stored as prefix+name and rendered once, when
+// the archive is written
+type Output struct {
+	prefix  string
+	name    string
+	link    string
+	render  func() []byte
+	content *bytes.Buffer
+}
+
+// NewOutput returns an output named prefix+name, e.g. "/docker/" and
+// "daemon.json", with an optional short link
+func NewOutput(prefix, name, link string, render func() []byte) *Output {
+	return &Output{prefix: prefix, name: name, link: link, render: render}
+}
+
+// Bytes renders content that is already known
+func Bytes(b []byte) func() []byte {
+	return func() []byte { return b }
+}
+
+func (o *Output) Content() *bytes.Buffer {
+	if o.content == nil {
+		o.content = bytes.NewBuffer(o.render())
+	}
+	return o.content
+}
+
+func (o *Output) Header() *tar.Header {
+	return Header(o.Content(), o.Name())
+}
+
+func (o *Output) Name() string {
+	return o.prefix + o.name
+}
+
+func (o *Output) Link() string {
+	return o.link
+}
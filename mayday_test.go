@@ -3,7 +3,10 @@ package main
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/coreos/mayday/mayday/plugins/command"
+	"github.com/coreos/mayday/mayday/plugins/journal"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
@@ -48,3 +51,48 @@ func TestConfigStruct(t *testing.T) {
 	assert.EqualValues(t, C.Files[0], File{Name: "/proc/vmstat"})
 	assert.EqualValues(t, C.Files[1], File{Name: "/proc/meminfo", Link: "meminfo"})
 }
+
+func TestConfigLimits(t *testing.T) {
+	viper.SetConfigType("json")
+	viper.ReadConfig(strings.NewReader(`{
+  "limits": {"nice": 10, "timeout": "1m"},
+  "commands": [
+    {"args": ["lsof"], "limits": {"memory_mb": 512, "user": "nobody"}}
+  ]
+}`))
+
+	var C Config
+	viper.Unmarshal(&C)
+
+	nice := 10
+	assert.EqualValues(t, C.Limits, command.Limits{Nice: &nice, Timeout: time.Minute})
+	assert.EqualValues(t, C.Commands[0].Limits, command.Limits{MemoryMB: 512, User: "nobody"})
+}
+
+func TestConfigJournal(t *testing.T) {
+	viper.SetConfigType("json")
+	viper.ReadConfig(strings.NewReader(`{
+  "journal": {
+    "since": "-1d",
+    "priority": "warning",
+    "include": [{"type": "^service$"}, {"path": "^/etc/"}],
+    "kernel": false,
+    "units": [
+      {"name": "docker.service", "boot": "previous", "lines": 500}
+    ]
+  }
+}`))
+
+	var C Config
+	viper.Unmarshal(&C)
+
+	assert.Equal(t, C.Journal.Since, "-1d")
+	assert.Equal(t, C.Journal.Priority, "warning")
+	assert.Len(t, C.Journal.Units, 1)
+	assert.Equal(t, C.Journal.Units[0].Name, "docker.service")
+	assert.Equal(t, C.Journal.Units[0].Boot, "previous")
+	assert.Equal(t, C.Journal.Units[0].Lines, 500)
+	assert.Equal(t, C.Journal.Include, []journal.Rule{{Type: "^service$"}, {Path: "^/etc/"}})
+	assert.False(t, *C.Journal.Kernel)
+	assert.Nil(t, C.Journal.Failed)
+}